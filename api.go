@@ -0,0 +1,119 @@
+package sleeper
+
+import (
+	"context"
+)
+
+// LeagueAPI is the set of league methods implemented by *Client. Fakes used in tests
+// can embed the interface and only implement the methods they need.
+type LeagueAPI interface {
+	GetAllLeagesForUser(user_id string, sport Sport, season int) ([]League, error)
+	GetAllLeagesForUserContext(ctx context.Context, user_id string, sport Sport, season int) ([]League, error)
+	GetLeague(league_id string) (League, error)
+	GetLeagueContext(ctx context.Context, league_id string) (League, error)
+	GetRosters(league_id string) ([]Roster, error)
+	GetRostersContext(ctx context.Context, league_id string) ([]Roster, error)
+	GetLeagueUsers(league_id string) ([]LeagueUser, error)
+	GetLeagueUsersContext(ctx context.Context, league_id string) ([]LeagueUser, error)
+	GetMatchups(league_id string, week int) ([]Matchup, error)
+	GetMatchupsContext(ctx context.Context, league_id string, week int) ([]Matchup, error)
+	GetPlayoffsWinnersBracket(league_id string) ([]PlayoffRound, error)
+	GetPlayoffsWinnersBracketContext(ctx context.Context, league_id string) ([]PlayoffRound, error)
+	GetPlayoffsLosersBracket(league_id string) ([]PlayoffRound, error)
+	GetPlayoffsLosersBracketContext(ctx context.Context, league_id string) ([]PlayoffRound, error)
+	GetTransactions(league_id string, round int) ([]Transaction, error)
+	GetTransactionsContext(ctx context.Context, league_id string, round int) ([]Transaction, error)
+	GetLeagueTradedPicks(league_id string) ([]TradedPick, error)
+	GetLeagueTradedPicksContext(ctx context.Context, league_id string) ([]TradedPick, error)
+	GetSportState(sport Sport) (SportState, error)
+	GetSportStateContext(ctx context.Context, sport Sport) (SportState, error)
+}
+
+// DraftAPI is the set of draft methods implemented by *Client.
+type DraftAPI interface {
+	GetDraftsForUser(user_id string, sport Sport, season int) ([]Draft, error)
+	GetDraftsForUserContext(ctx context.Context, user_id string, sport Sport, season int) ([]Draft, error)
+	GetDraftsForLeague(league_id string) ([]Draft, error)
+	GetDraftsForLeagueContext(ctx context.Context, league_id string) ([]Draft, error)
+	GetDraft(draft_id string) (Draft, error)
+	GetDraftContext(ctx context.Context, draft_id string) (Draft, error)
+	GetAllDraftPicks(draft_id string) ([]DraftPlayer, error)
+	GetAllDraftPicksContext(ctx context.Context, draft_id string) ([]DraftPlayer, error)
+	GetDraftTradedPicks(draft_id string) ([]TradedPick, error)
+	GetDraftTradedPicksContext(ctx context.Context, draft_id string) ([]TradedPick, error)
+}
+
+// PlayerAPI is the set of player methods implemented by *Client.
+type PlayerAPI interface {
+	GetAllPlayers(sport Sport) (Players, error)
+	GetAllPlayersContext(ctx context.Context, sport Sport) (Players, error)
+	SaveAllPlayers(sport Sport, file string) (bool, error)
+	SaveAllPlayersContext(ctx context.Context, sport Sport, file string) (bool, error)
+	StreamAllPlayers(sport Sport, fn func(id string, player Player) error) error
+	StreamAllPlayersContext(ctx context.Context, sport Sport, fn func(id string, player Player) error) error
+	GetTrendingPlayers(sport Sport, trending_type string) ([]TrendingPlayer, error)
+	GetTrendingPlayersContext(ctx context.Context, sport Sport, trending_type string) ([]TrendingPlayer, error)
+	GetTrendingPlayersParams(sport Sport, trending_type string, hours int, limit int) ([]TrendingPlayer, error)
+	GetTrendingPlayersParamsContext(ctx context.Context, sport Sport, trending_type string, hours int, limit int) ([]TrendingPlayer, error)
+}
+
+// NFLDataAPI is the set of methods for the undocumented NFL endpoints implemented by *Client.
+type NFLDataAPI interface {
+	GetNflProjections(season int, week int) (Projections, error)
+	GetNflProjectionsContext(ctx context.Context, season int, week int) (Projections, error)
+	GetNflSchedule(year int, postseason bool) (NflSchedule, error)
+	GetNflScheduleContext(ctx context.Context, year int, postseason bool) (NflSchedule, error)
+	GetNflTeamDepthChart(team string) (TeamDepthChart, error)
+	GetNflTeamDepthChartContext(ctx context.Context, team string) (TeamDepthChart, error)
+	GetNflPlayer(playerID int) (Player, error)
+	GetNflPlayerContext(ctx context.Context, playerID int) (Player, error)
+	GetNflPlayerResearch(year int, week int, postseason bool) (map[string]PlayerResearch, error)
+	GetNflPlayerResearchContext(ctx context.Context, year int, week int, postseason bool) (map[string]PlayerResearch, error)
+	GetNflPlayerSeasonStats(playerID int, year int, postseason bool) (PlayerStats, error)
+	GetNflPlayerSeasonStatsContext(ctx context.Context, playerID int, year int, postseason bool) (PlayerStats, error)
+}
+
+// StatsAPI is the set of methods for the undocumented endpoints that accept a sport implemented by *Client.
+type StatsAPI interface {
+	GetProjections(sport Sport, season int, week int) (Projections, error)
+	GetProjectionsContext(ctx context.Context, sport Sport, season int, week int) (Projections, error)
+	GetSchedule(sport Sport, year int, postseason bool) (Schedule, error)
+	GetScheduleContext(ctx context.Context, sport Sport, year int, postseason bool) (Schedule, error)
+	GetPlayer(sport Sport, playerID int) (Player, error)
+	GetPlayerContext(ctx context.Context, sport Sport, playerID int) (Player, error)
+	GetPlayerResearch(sport Sport, year int, week int, postseason bool) (map[string]PlayerResearch, error)
+	GetPlayerResearchContext(ctx context.Context, sport Sport, year int, week int, postseason bool) (map[string]PlayerResearch, error)
+	GetPlayerSeasonStats(sport Sport, playerID int, year int, postseason bool) (PlayerStats, error)
+	GetPlayerSeasonStatsContext(ctx context.Context, sport Sport, playerID int, year int, postseason bool) (PlayerStats, error)
+}
+
+// UserAPI is the set of user and avatar methods implemented by *Client.
+type UserAPI interface {
+	GetUserByUsername(username string) (User, error)
+	GetUserByUsernameContext(ctx context.Context, username string) (User, error)
+	GetUserByID(id string) (User, error)
+	GetUserByIDContext(ctx context.Context, id string) (User, error)
+	GetAvatar(avatar_id string) ([]byte, error)
+	GetAvatarContext(ctx context.Context, avatar_id string) ([]byte, error)
+	GetAvatarThumbnail(avatar_id string) ([]byte, error)
+	GetAvatarThumbnailContext(ctx context.Context, avatar_id string) ([]byte, error)
+}
+
+// API is every method of the Sleeper API implemented by *Client.
+type API interface {
+	LeagueAPI
+	DraftAPI
+	PlayerAPI
+	NFLDataAPI
+	StatsAPI
+	UserAPI
+}
+
+var _ API = (*Client)(nil)
+
+// Get the client behind the API for debug records, nil for other implementations.
+// The logging helpers do nothing on a nil client.
+func clientOf(api any) *Client {
+	c, _ := api.(*Client)
+	return c
+}
@@ -0,0 +1,107 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// Fake league API with canned responses, unimplemented methods panic.
+type fakeLeagueAPI struct {
+	LeagueAPI
+	league   League
+	state    SportState
+	matchups map[int][]Matchup
+	rosters  []Roster
+	users    []LeagueUser
+	winners  []PlayoffRound
+	losers   []PlayoffRound
+	err      error
+}
+
+func (f *fakeLeagueAPI) GetLeagueContext(ctx context.Context, league_id string) (League, error) {
+	return f.league, f.err
+}
+
+func (f *fakeLeagueAPI) GetSportStateContext(ctx context.Context, sport Sport) (SportState, error) {
+	return f.state, f.err
+}
+
+func (f *fakeLeagueAPI) GetMatchupsContext(ctx context.Context, league_id string, week int) ([]Matchup, error) {
+	return f.matchups[week], f.err
+}
+
+func (f *fakeLeagueAPI) GetRostersContext(ctx context.Context, league_id string) ([]Roster, error) {
+	return f.rosters, f.err
+}
+
+func (f *fakeLeagueAPI) GetLeagueUsersContext(ctx context.Context, league_id string) ([]LeagueUser, error) {
+	return f.users, f.err
+}
+
+func (f *fakeLeagueAPI) GetPlayoffsWinnersBracketContext(ctx context.Context, league_id string) ([]PlayoffRound, error) {
+	return f.winners, f.err
+}
+
+func (f *fakeLeagueAPI) GetPlayoffsLosersBracketContext(ctx context.Context, league_id string) ([]PlayoffRound, error) {
+	return f.losers, f.err
+}
+
+func newFakeLeagueAPI() *fakeLeagueAPI {
+	f := &fakeLeagueAPI{
+		league: League{LeagueID: "1", Sport: "nfl"},
+		state:  SportState{Week: 3, SeasonType: "regular"},
+		matchups: map[int][]Matchup{
+			3: {
+				{RosterID: 1, MatchupID: 1, Points: 120.5},
+				{RosterID: 2, MatchupID: 1, Points: 98},
+			},
+		},
+		rosters: []Roster{
+			{RosterID: 1, OwnerID: "a"},
+			{RosterID: 2, OwnerID: "b"},
+		},
+		users: []LeagueUser{
+			{UserID: "a", DisplayName: "alice"},
+			{UserID: "b", DisplayName: "bob"},
+		},
+	}
+	f.rosters[0].Settings.Wins = 2
+	f.rosters[1].Settings.Losses = 2
+	f.users[0].Metadata.TeamName = "Team A"
+	return f
+}
+
+func TestFetchTeamMatchupsWithFake(t *testing.T) {
+	matchups, err := FetchTeamMatchups(context.Background(), newFakeLeagueAPI(), "1", 0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := []TeamMatchup{{Teamname1: "Team A", Teamname2: "Team bob", Team1Wins: 2, Team2Losses: 2}}
+	if !slices.Equal(matchups, want) {
+		t.Errorf("Expected %+v, got %+v", want, matchups)
+	}
+}
+
+func TestFetchScoreboardsWithFake(t *testing.T) {
+	scoreboards, err := FetchScoreboards(context.Background(), newFakeLeagueAPI(), "1", 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := []Scoreboard{{Teamname1: "Team A", Teamname2: "Team bob", Points1: 120.5, Points2: 98}}
+	if !slices.Equal(scoreboards, want) {
+		t.Errorf("Expected %+v, got %+v", want, scoreboards)
+	}
+}
+
+func TestFetchScoreboardsWithFakeError(t *testing.T) {
+	fake := newFakeLeagueAPI()
+	fake.err = ErrNotFound
+
+	if _, err := FetchScoreboards(context.Background(), fake, "1", 3); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
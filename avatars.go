@@ -1,6 +1,9 @@
 package sleeper
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 const (
 	avatarBaseURL string = "https://sleepercdn.com/avatars"
@@ -9,13 +12,23 @@ const (
 // Get the user's avatar picture.
 // (GET `https://sleepercdn.com/avatars/<avatar_id>`)
 func (c *Client) GetAvatar(avatar_id string) ([]byte, error) {
+	return c.GetAvatarContext(context.Background(), avatar_id)
+}
+
+// GetAvatarContext is like GetAvatar but accepts a context.
+func (c *Client) GetAvatarContext(ctx context.Context, avatar_id string) ([]byte, error) {
 	url := fmt.Sprintf("%s/%s", avatarBaseURL, avatar_id)
-	return c.getRequest(url)
+	return c.getRequestContext(ctx, url)
 }
 
 // Get the user's avatar picture thumbnail.
 // (GET `https://sleepercdn.com/avatars/thumbs/<avatar_id>`)
 func (c *Client) GetAvatarThumbnail(avatar_id string) ([]byte, error) {
+	return c.GetAvatarThumbnailContext(context.Background(), avatar_id)
+}
+
+// GetAvatarThumbnailContext is like GetAvatarThumbnail but accepts a context.
+func (c *Client) GetAvatarThumbnailContext(ctx context.Context, avatar_id string) ([]byte, error) {
 	url := fmt.Sprintf("%s/thumbs/%s", avatarBaseURL, avatar_id)
-	return c.getRequest(url)
+	return c.getRequestContext(ctx, url)
 }
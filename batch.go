@@ -0,0 +1,151 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// LeagueResource selects what GetLeaguesBatch fetches for each league. Resources can be combined with |.
+type LeagueResource int
+
+const (
+	LeagueInfo LeagueResource = 1 << iota
+	LeagueRosters
+	LeagueUsers
+	LeagueMatchups
+
+	LeagueAll = LeagueInfo | LeagueRosters | LeagueUsers | LeagueMatchups
+)
+
+// DefaultBatchConcurrency is the number of leagues fetched at once when BatchOptions.Concurrency is not set.
+const DefaultBatchConcurrency = 4
+
+// BatchOptions control what GetLeaguesBatch fetches and how many leagues are fetched at once.
+type BatchOptions struct {
+	Resources   LeagueResource // Resources to fetch, zero fetches LeagueAll
+	Week        int            // Week for LeagueMatchups, zero or less uses the current week for each league's sport
+	Concurrency int            // Leagues fetched at once, defaults to DefaultBatchConcurrency
+}
+
+// LeagueBatchResult holds the resources fetched for one league. Resources that failed
+// are left empty and their errors are joined in Err.
+type LeagueBatchResult struct {
+	LeagueID string
+	League   League
+	Rosters  []Roster
+	Users    []LeagueUser
+	Matchups []Matchup
+	Err      error
+}
+
+// Get the league, rosters, users, and matchups for many leagues using a bounded number of
+// workers. Requests still share the client's rate limiter. Results are returned in the same
+// order as the league IDs and a failure for one league does not stop the others.
+func (c *Client) GetLeaguesBatch(league_ids []string, opts BatchOptions) []LeagueBatchResult {
+	return c.GetLeaguesBatchContext(context.Background(), league_ids, opts)
+}
+
+// GetLeaguesBatchContext is like GetLeaguesBatch but accepts a context.
+func (c *Client) GetLeaguesBatchContext(ctx context.Context, league_ids []string, opts BatchOptions) []LeagueBatchResult {
+	if opts.Resources == 0 {
+		opts.Resources = LeagueAll
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultBatchConcurrency
+	}
+
+	results := make([]LeagueBatchResult, len(league_ids))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(opts.Concurrency, len(league_ids)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = c.getLeagueBatch(ctx, league_ids[i], opts)
+			}
+		}()
+	}
+
+	for i := range league_ids {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// Fetch the selected resources for a single league.
+func (c *Client) getLeagueBatch(ctx context.Context, league_id string, opts BatchOptions) LeagueBatchResult {
+	result := LeagueBatchResult{LeagueID: league_id}
+
+	// Skip leagues that have not started once the batch is cancelled
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+
+	var errs []error
+	if opts.Resources&LeagueInfo != 0 {
+		league, err := c.GetLeagueContext(ctx, league_id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("league: %w", err))
+		}
+		result.League = league
+	}
+
+	if opts.Resources&LeagueRosters != 0 {
+		rosters, err := c.GetRostersContext(ctx, league_id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rosters: %w", err))
+		}
+		result.Rosters = rosters
+	}
+
+	if opts.Resources&LeagueUsers != 0 {
+		users, err := c.GetLeagueUsersContext(ctx, league_id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("users: %w", err))
+		}
+		result.Users = users
+	}
+
+	if opts.Resources&LeagueMatchups != 0 {
+		matchups, err := c.getLeagueBatchMatchups(ctx, league_id, result.League, opts.Week)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("matchups: %w", err))
+		}
+		result.Matchups = matchups
+	}
+
+	result.Err = errors.Join(errs...)
+	return result
+}
+
+// Fetch the matchups for the week, resolving the current week from the league's sport when
+// the week is zero or less. The league is fetched when it was not part of the batch, and the
+// sport state is shared between leagues through the cache or request coalescing.
+func (c *Client) getLeagueBatchMatchups(ctx context.Context, league_id string, league League, week int) ([]Matchup, error) {
+	if week > 0 {
+		return c.GetMatchupsContext(ctx, league_id, week)
+	}
+
+	if league.LeagueID == "" {
+		l, err := c.GetLeagueContext(ctx, league_id)
+		if err != nil {
+			return nil, err
+		}
+		league = l
+	}
+
+	current, err := currentWeek(ctx, c, league.Sport)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetMatchupsContext(ctx, league_id, current)
+}
@@ -0,0 +1,185 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetLeaguesBatch(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/league/"), "/")
+		id := parts[0]
+		if id == "bad" && len(parts) > 1 && parts[1] == "rosters" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch {
+		case len(parts) == 1:
+			fmt.Fprintf(w, `{"league_id":%q,"name":"League %s"}`, id, id)
+		case parts[1] == "rosters":
+			fmt.Fprintf(w, `[{"roster_id":1,"league_id":%q}]`, id)
+		case parts[1] == "users":
+			w.Write([]byte(`[{"user_id":"1"},{"user_id":"2"}]`))
+		case parts[1] == "matchups":
+			w.Write([]byte(`[{"roster_id":1,"matchup_id":1,"points":100}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, RateLimit: 1000})
+
+	ids := []string{"1", "2", "bad", "4", "5", "6"}
+	results := client.GetLeaguesBatch(ids, BatchOptions{Week: 3, Concurrency: 2})
+
+	if len(results) != len(ids) {
+		t.Fatalf("Expected %d results, got %d", len(ids), len(results))
+	}
+
+	for i, r := range results {
+		if r.LeagueID != ids[i] {
+			t.Errorf("Expected result %d for league %s, got %s", i, ids[i], r.LeagueID)
+		}
+		if r.League.LeagueID != ids[i] {
+			t.Errorf("Expected league %s, got %q", ids[i], r.League.LeagueID)
+		}
+		if len(r.Users) != 2 || len(r.Matchups) != 1 {
+			t.Errorf("Expected 2 users and 1 matchup for league %s, got %d and %d", ids[i], len(r.Users), len(r.Matchups))
+		}
+
+		if ids[i] == "bad" {
+			if !errors.Is(r.Err, ErrNotFound) {
+				t.Errorf("Expected ErrNotFound for league bad, got %v", r.Err)
+			}
+			if len(r.Rosters) != 0 {
+				t.Errorf("Expected no rosters for league bad, got %+v", r.Rosters)
+			}
+			continue
+		}
+
+		if r.Err != nil {
+			t.Errorf("Expected no error for league %s, got %v", ids[i], r.Err)
+		}
+		if len(r.Rosters) != 1 {
+			t.Errorf("Expected 1 roster for league %s, got %d", ids[i], len(r.Rosters))
+		}
+	}
+
+	if n := maxInFlight.Load(); n > 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", n)
+	}
+}
+
+func TestGetLeaguesBatchResources(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, RateLimit: 1000})
+
+	results := client.GetLeaguesBatch([]string{"1", "2", "3"}, BatchOptions{Resources: LeagueRosters | LeagueUsers})
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("Expected no error, got %v", r.Err)
+		}
+	}
+
+	if n := hits.Load(); n != 6 {
+		t.Errorf("Expected 6 requests, got %d", n)
+	}
+}
+
+func TestGetLeaguesBatchCancelled(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := client.GetLeaguesBatchContext(ctx, []string{"1", "2"}, BatchOptions{})
+	for _, r := range results {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", r.Err)
+		}
+	}
+
+	if n := hits.Load(); n != 0 {
+		t.Errorf("Expected no requests, got %d", n)
+	}
+}
+
+func TestGetLeaguesBatchCurrentWeek(t *testing.T) {
+	var stateHits atomic.Int32
+	var mu sync.Mutex
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/v1/state/nfl":
+			stateHits.Add(1)
+			w.Write([]byte(`{"week":5,"season_type":"regular"}`))
+		case strings.HasSuffix(r.URL.Path, "/matchups/5"):
+			w.Write([]byte(`[{"roster_id":1,"matchup_id":1,"points":100}]`))
+		case strings.Contains(r.URL.Path, "/matchups/"):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			id := strings.TrimPrefix(r.URL.Path, "/v1/league/")
+			fmt.Fprintf(w, `{"league_id":%q,"sport":"nfl"}`, id)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, RateLimit: 1000})
+
+	results := client.GetLeaguesBatch([]string{"1", "2", "3"}, BatchOptions{Resources: LeagueMatchups})
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("Expected no error for league %s, got %v", r.LeagueID, r.Err)
+		}
+		if len(r.Matchups) != 1 {
+			t.Errorf("Expected 1 matchup for league %s, got %d", r.LeagueID, len(r.Matchups))
+		}
+	}
+
+	if stateHits.Load() == 0 {
+		t.Error("Expected the sport state to be fetched")
+	}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "/matchups/0") {
+			t.Errorf("Unexpected request for week 0: %s", path)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package sleeper
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// BracketTeam is a team in a playoff bracket.
+type BracketTeam struct {
+	RosterID    int    `json:"roster_id"`
+	OwnerID     string `json:"owner_id"`
+	DisplayName string `json:"display_name"`
+	TeamName    string `json:"team_name"` // Team name, or "Team " and the display name when it is not set
+}
+
+// BracketSource is the earlier match a team comes from.
+type BracketSource struct {
+	Match  int         `json:"match"`
+	Result MatchResult `json:"result"` // ResultWin for the winner of the match, ResultLoss for the loser
+
+	From *BracketMatch `json:"-"` // The earlier match, nil when it is not in the bracket
+}
+
+// BracketMatch is one match in a playoff bracket. Teams are nil until they are known.
+type BracketMatch struct {
+	Round     int            `json:"round"`
+	Match     int            `json:"match"`
+	Team1     *BracketTeam   `json:"team_1,omitempty"`
+	Team2     *BracketTeam   `json:"team_2,omitempty"`
+	Team1From *BracketSource `json:"team_1_from,omitempty"`
+	Team2From *BracketSource `json:"team_2_from,omitempty"`
+	Winner    *BracketTeam   `json:"winner,omitempty"`
+	Loser     *BracketTeam   `json:"loser,omitempty"`
+	Placement int            `json:"placement,omitempty"` // Place the winner finishes in, zero when the match does not decide a place
+
+	Next []*BracketMatch `json:"-"` // Later matches the winner or loser plays in
+}
+
+// Name returns a short name for the match, such as "Round 2 Match 5".
+func (m *BracketMatch) Name() string {
+	return fmt.Sprintf("Round %d Match %d", m.Round, m.Match)
+}
+
+// Bracket is a playoff bracket with its matches linked to the matches teams come from.
+type Bracket struct {
+	Losers  bool            `json:"losers"`  // The bracket is the losers bracket
+	Matches []*BracketMatch `json:"matches"` // Ordered by round, then match
+}
+
+// Brackets holds the winners and losers brackets for a league.
+type Brackets struct {
+	Winners Bracket `json:"winners"`
+	Losers  Bracket `json:"losers"`
+}
+
+// Match returns the match with the ID.
+func (b Bracket) Match(match int) (*BracketMatch, bool) {
+	for _, m := range b.Matches {
+		if m.Match == match {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// Rounds returns the number of rounds in the bracket.
+func (b Bracket) Rounds() int {
+	rounds := 0
+	for _, m := range b.Matches {
+		rounds = max(rounds, m.Round)
+	}
+	return rounds
+}
+
+// Round returns the matches in the round.
+func (b Bracket) Round(round int) []*BracketMatch {
+	var matches []*BracketMatch
+	for _, m := range b.Matches {
+		if m.Round == round {
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// Placements returns the matches that decide a place, ordered by place.
+func (b Bracket) Placements() []*BracketMatch {
+	var matches []*BracketMatch
+	for _, m := range b.Matches {
+		if m.Placement > 0 {
+			matches = append(matches, m)
+		}
+	}
+	slices.SortFunc(matches, func(a, b *BracketMatch) int { return cmp.Compare(a.Placement, b.Placement) })
+	return matches
+}
+
+// Final returns the match for first place.
+func (b Bracket) Final() (*BracketMatch, bool) {
+	for _, m := range b.Matches {
+		if m.Placement == 1 {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// Champion returns the winner of the match for first place, nil until it is played.
+func (b Bracket) Champion() *BracketTeam {
+	final, ok := b.Final()
+	if !ok {
+		return nil
+	}
+	return final.Winner
+}
+
+// String renders the bracket as text with one line for each match.
+func (b Bracket) String() string {
+	var sb strings.Builder
+
+	title := "Winners Bracket"
+	if b.Losers {
+		title = "Losers Bracket"
+	}
+	sb.WriteString(title + "\n")
+
+	for round := 1; round <= b.Rounds(); round++ {
+		matches := b.Round(round)
+		if len(matches) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "Round %d\n", round)
+		for _, m := range matches {
+			fmt.Fprintf(&sb, "  Match %d: %s vs %s", m.Match, bracketSlot(m.Team1, m.Team1From), bracketSlot(m.Team2, m.Team2From))
+			if m.Placement > 0 {
+				fmt.Fprintf(&sb, " [%s place]", ordinal(m.Placement))
+			}
+			if m.Winner != nil {
+				fmt.Fprintf(&sb, " - winner %s", m.Winner.TeamName)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// Get the winners and losers brackets with each roster resolved to its team and owner.
+// (GET `https://api.sleeper.app/v1/league/<league_id>/winners_bracket`)
+// (GET `https://api.sleeper.app/v1/league/<league_id>/losers_bracket`)
+func (c *Client) GetPlayoffBrackets(league_id string) (Brackets, error) {
+	return c.GetPlayoffBracketsContext(context.Background(), league_id)
+}
+
+// GetPlayoffBracketsContext is like GetPlayoffBrackets but accepts a context.
+func (c *Client) GetPlayoffBracketsContext(ctx context.Context, league_id string) (Brackets, error) {
+	return FetchPlayoffBrackets(ctx, c, league_id)
+}
+
+// FetchPlayoffBrackets gets both brackets, the rosters, and the users from the api and
+// links each bracket with BuildBracket.
+func FetchPlayoffBrackets(ctx context.Context, api LeagueAPI, league_id string) (Brackets, error) {
+	brackets := Brackets{}
+
+	winners, err := api.GetPlayoffsWinnersBracketContext(ctx, league_id)
+	if err != nil {
+		return brackets, err
+	}
+
+	losers, err := api.GetPlayoffsLosersBracketContext(ctx, league_id)
+	if err != nil {
+		return brackets, err
+	}
+
+	rosters, err := api.GetRostersContext(ctx, league_id)
+	if err != nil {
+		return brackets, err
+	}
+
+	users, err := api.GetLeagueUsersContext(ctx, league_id)
+	if err != nil {
+		return brackets, err
+	}
+
+	brackets.Winners = BuildBracket(winners, rosters, users, false)
+	brackets.Losers = BuildBracket(losers, rosters, users, true)
+
+	return brackets, nil
+}
+
+// BuildBracket links the bracket matches through the matches teams come from and resolves
+// each roster ID to its team and owner without sending any requests.
+func BuildBracket(rounds []PlayoffRound, rosters []Roster, users []LeagueUser, losers bool) Bracket {
+	bracket := Bracket{Losers: losers}
+
+	usersByID := make(map[string]LeagueUser, len(users))
+	for _, user := range users {
+		usersByID[user.UserID] = user
+	}
+
+	teams := make(map[int]*BracketTeam, len(rosters))
+	for _, roster := range rosters {
+		team := &BracketTeam{
+			RosterID: roster.RosterID,
+			OwnerID:  roster.OwnerID,
+			TeamName: fmt.Sprintf("Team %d", roster.RosterID),
+		}
+		if user, ok := usersByID[roster.OwnerID]; ok {
+			team.DisplayName = user.DisplayName
+			team.TeamName = teamName(user)
+		}
+		teams[roster.RosterID] = team
+	}
+
+	// Rosters missing from the league still get a team so the bracket is complete
+	team := func(roster_id int) *BracketTeam {
+		if roster_id == 0 {
+			return nil
+		}
+		if _, ok := teams[roster_id]; !ok {
+			teams[roster_id] = &BracketTeam{RosterID: roster_id, TeamName: fmt.Sprintf("Team %d", roster_id)}
+		}
+		return teams[roster_id]
+	}
+
+	byMatch := make(map[int]*BracketMatch, len(rounds))
+	for _, r := range rounds {
+		m := &BracketMatch{
+			Round:     r.R,
+			Match:     r.M,
+			Team1:     team(r.T1),
+			Team2:     team(r.T2),
+			Team1From: bracketSource(r.T1From.W, r.T1From.L),
+			Team2From: bracketSource(r.T2From.W, r.T2From.L),
+			Winner:    team(r.W),
+			Loser:     team(r.L),
+			Placement: r.P,
+		}
+		bracket.Matches = append(bracket.Matches, m)
+		byMatch[m.Match] = m
+	}
+
+	slices.SortStableFunc(bracket.Matches, func(a, b *BracketMatch) int {
+		return cmp.Or(cmp.Compare(a.Round, b.Round), cmp.Compare(a.Match, b.Match))
+	})
+
+	// Link each match to the matches its teams come from
+	for _, m := range bracket.Matches {
+		for _, source := range []*BracketSource{m.Team1From, m.Team2From} {
+			if source == nil {
+				continue
+			}
+			if from, ok := byMatch[source.Match]; ok {
+				source.From = from
+				from.Next = append(from.Next, m)
+			}
+		}
+	}
+
+	return bracket
+}
+
+// Get the source for a team from the winner or loser match ID, nil when there is neither.
+func bracketSource(winner, loser int) *BracketSource {
+	switch {
+	case winner != 0:
+		return &BracketSource{Match: winner, Result: ResultWin}
+	case loser != 0:
+		return &BracketSource{Match: loser, Result: ResultLoss}
+	default:
+		return nil
+	}
+}
+
+// Get the text for a team in a match, or where it comes from when it is not known yet.
+func bracketSlot(team *BracketTeam, source *BracketSource) string {
+	switch {
+	case team != nil:
+		return team.TeamName
+	case source != nil && source.Result == ResultWin:
+		return fmt.Sprintf("Winner of match %d", source.Match)
+	case source != nil:
+		return fmt.Sprintf("Loser of match %d", source.Match)
+	default:
+		return "TBD"
+	}
+}
+
+// Get the ordinal for a place, such as 1st or 3rd.
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,118 @@
+package sleeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Four team bracket where the final has not been played and roster 2 won third place.
+func newBracketFake() *fakeLeagueAPI {
+	f := newStandingsFake()
+	f.winners = []PlayoffRound{
+		{R: 2, M: 3, T1: 1, T2: 3, P: 1},
+		{R: 1, M: 1, T1: 1, T2: 4, W: 1, L: 4},
+		{R: 1, M: 2, T1: 2, T2: 3, W: 3, L: 2},
+		{R: 2, M: 4, T1: 4, T2: 2, W: 2, L: 4, P: 3},
+	}
+	f.winners[0].T1From.W = 1
+	f.winners[0].T2From.W = 2
+	f.winners[3].T1From.L = 1
+	f.winners[3].T2From.L = 2
+	f.losers = []PlayoffRound{
+		{R: 1, M: 1, T1: 5, T2: 6, P: 1},
+	}
+	return f
+}
+
+func TestFetchPlayoffBrackets(t *testing.T) {
+	brackets, err := FetchPlayoffBrackets(context.Background(), newBracketFake(), "1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	winners := brackets.Winners
+	if winners.Losers || winners.Rounds() != 2 || len(winners.Round(1)) != 2 {
+		t.Fatalf("Expected 2 rounds with 2 matches in round 1, got %+v", winners)
+	}
+
+	final, ok := winners.Final()
+	if !ok || final.Match != 3 || final.Name() != "Round 2 Match 3" {
+		t.Fatalf("Expected match 3 to be the final, got %+v", final)
+	}
+	if final.Team1From.From != winners.Matches[0] || final.Team1From.Result != ResultWin {
+		t.Errorf("Expected the final to be linked to the winner of match 1, got %+v", final.Team1From)
+	}
+	if final.Team1.TeamName != "Team A" || final.Team2.DisplayName != "carol" || final.Team2.OwnerID != "c" {
+		t.Errorf("Expected Team A to play carol, got %+v and %+v", final.Team1, final.Team2)
+	}
+	if winners.Champion() != nil {
+		t.Errorf("Expected no champion before the final is played, got %+v", winners.Champion())
+	}
+
+	first, _ := winners.Match(1)
+	if len(first.Next) != 2 || first.Next[0].Match != 3 || first.Next[1].Match != 4 {
+		t.Errorf("Expected match 1 to lead to matches 3 and 4, got %v", first.Next)
+	}
+
+	placements := winners.Placements()
+	if len(placements) != 2 || placements[1].Placement != 3 || placements[1].Winner.RosterID != 2 {
+		t.Errorf("Expected roster 2 to win third place, got %+v", placements)
+	}
+
+	// Rosters that are not in the league still get a team
+	if m, _ := brackets.Losers.Match(1); !brackets.Losers.Losers || m.Team1.TeamName != "Team 5" {
+		t.Errorf("Expected roster 5 to be Team 5, got %+v", m.Team1)
+	}
+}
+
+func TestBracketString(t *testing.T) {
+	f := newBracketFake()
+	f.winners[0].T1, f.winners[0].T2 = 0, 0
+
+	bracket := BuildBracket(f.winners, f.rosters, f.users, false)
+
+	want := `Winners Bracket
+Round 1
+  Match 1: Team A vs Team dave - winner Team A
+  Match 2: Team bob vs Team carol - winner Team carol
+Round 2
+  Match 3: Winner of match 1 vs Winner of match 2 [1st place]
+  Match 4: Team dave vs Team bob [3rd place] - winner Team bob
+`
+	if got := bracket.String(); got != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestBracketJSON(t *testing.T) {
+	f := newBracketFake()
+	bracket := BuildBracket(f.winners, f.rosters, f.users, false)
+
+	data, err := json.Marshal(bracket)
+	if err != nil {
+		t.Fatalf("Failed to marshal bracket: %v", err)
+	}
+
+	for _, want := range []string{
+		`"team_1_from":{"match":1,"result":"W"}`,
+		`"team_2_from":{"match":2,"result":"L"}`,
+		`"winner":{"roster_id":2,"owner_id":"b","display_name":"bob","team_name":"Team bob"}`,
+		`"placement":3`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Expected %s in %s", want, data)
+		}
+	}
+}
+
+func TestFetchPlayoffBracketsError(t *testing.T) {
+	f := newBracketFake()
+	f.err = ErrNotFound
+
+	if _, err := FetchPlayoffBrackets(context.Background(), f, "1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
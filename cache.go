@@ -0,0 +1,213 @@
+package sleeper
+
+import (
+	"container/list"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores API responses keyed by request URL.
+//
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get the cached response for the key if it has not expired.
+	Get(key string) ([]byte, bool)
+	// Set the response for the key, expiring after the ttl.
+	Set(key string, value []byte, ttl time.Duration)
+	// Delete the response for the key.
+	Delete(key string)
+	// Delete every response with a key starting with the prefix.
+	DeletePrefix(prefix string)
+}
+
+// DefaultCacheTTLs are the time-to-live values used for each endpoint when caching is enabled.
+// Endpoints with a TTL of zero are not cached.
+var DefaultCacheTTLs = map[string]time.Duration{
+	EndpointUser:              time.Hour,
+	EndpointUserLeagues:       time.Hour,
+	EndpointUserDrafts:        time.Hour,
+	EndpointLeague:            time.Hour,
+	EndpointRosters:           5 * time.Minute,
+	EndpointLeagueUsers:       time.Hour,
+	EndpointMatchups:          30 * time.Second,
+	EndpointWinnersBracket:    5 * time.Minute,
+	EndpointLosersBracket:     5 * time.Minute,
+	EndpointTransactions:      time.Minute,
+	EndpointLeagueTradedPicks: time.Hour,
+	EndpointLeagueDrafts:      time.Hour,
+	EndpointSportState:        5 * time.Minute,
+	EndpointDraft:             5 * time.Minute,
+	EndpointDraftPicks:        time.Minute,
+	EndpointDraftTradedPicks:  time.Hour,
+	EndpointPlayers:           24 * time.Hour,
+	EndpointTrendingPlayers:   15 * time.Minute,
+	EndpointPlayer:            24 * time.Hour,
+	EndpointPlayerResearch:    time.Hour,
+	EndpointPlayerStats:       time.Hour,
+	EndpointProjections:       time.Hour,
+	EndpointSchedule:          24 * time.Hour,
+	EndpointNflTeamDepthChart: 6 * time.Hour,
+	EndpointAvatar:            24 * time.Hour,
+	EndpointAvatarThumbnail:   24 * time.Hour,
+}
+
+// Get the cache TTL for the request URL.
+func (c *Client) cacheTTL(url string) time.Duration {
+	endpoint := c.endpoint(url)
+	if ttl, ok := c.cacheTTLs[endpoint]; ok {
+		return ttl
+	}
+	return DefaultCacheTTLs[endpoint]
+}
+
+// ClearCache removes every cached response.
+func (c *Client) ClearCache() {
+	if c.cache != nil {
+		c.cache.DeletePrefix("")
+	}
+}
+
+// InvalidateLeague removes every cached response for the league, such as its rosters, users and matchups.
+func (c *Client) InvalidateLeague(league_id string) {
+	c.invalidate(c.sleeperURL + "/v1/league/" + league_id)
+}
+
+// InvalidateDraft removes every cached response for the draft.
+func (c *Client) InvalidateDraft(draft_id string) {
+	c.invalidate(c.sleeperURL + "/v1/draft/" + draft_id)
+}
+
+// InvalidateUser removes every cached response for the user, including their leagues and drafts.
+func (c *Client) InvalidateUser(user string) {
+	c.invalidate(c.sleeperURL + "/v1/user/" + user)
+}
+
+// InvalidatePlayers removes the cached players and trending players for the sport.
+func (c *Client) InvalidatePlayers(sport Sport) {
+	c.invalidate(c.sleeperURL + "/v1/players/" + sport.String())
+}
+
+// Remove the URL and every URL below it from the cache.
+func (c *Client) invalidate(url string) {
+	if c.cache == nil {
+		return
+	}
+	c.cache.Delete(url)
+	c.cache.DeletePrefix(url + "/")
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used entries.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List
+}
+
+// NewMemoryCache creates an in-memory cache holding up to maxEntries responses.
+// A maxEntries of zero or less means there is no limit.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Get the cached response for the key if it has not expired.
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*memoryCacheEntry)
+	if time.Now().After(entry.expires) {
+		m.remove(elem)
+		return nil, false
+	}
+
+	m.order.MoveToFront(elem)
+	return slices.Clone(entry.value), true
+}
+
+// GetStale gets the cached response for the key even if it has expired.
+func (m *MemoryCache) GetStale(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	m.order.MoveToFront(elem)
+	return slices.Clone(elem.Value.(*memoryCacheEntry).value), true
+}
+
+// Set the response for the key, expiring after the ttl.
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expires := time.Now().Add(ttl)
+	if elem, ok := m.entries[key]; ok {
+		entry := elem.Value.(*memoryCacheEntry)
+		entry.value = slices.Clone(value)
+		entry.expires = expires
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{key: key, value: slices.Clone(value), expires: expires})
+
+	if m.maxEntries > 0 && m.order.Len() > m.maxEntries {
+		m.remove(m.order.Back())
+	}
+}
+
+// Delete the response for the key.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+}
+
+// Delete every response with a key starting with the prefix.
+func (m *MemoryCache) DeletePrefix(prefix string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, elem := range m.entries {
+		if strings.HasPrefix(key, prefix) {
+			m.remove(elem)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache, including expired ones not yet removed.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
+
+func (m *MemoryCache) remove(elem *list.Element) {
+	m.order.Remove(elem)
+	delete(m.entries, elem.Value.(*memoryCacheEntry).key)
+}
@@ -0,0 +1,160 @@
+package sleeper
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Header written on the first line of each file in a DiskCache.
+type diskCacheHeader struct {
+	Key     string    `json:"key"`
+	Expires time.Time `json:"expires"`
+}
+
+// DiskCache is a Cache that stores each response as a file in a directory,
+// so cached responses survive restarts.
+type DiskCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewDiskCache creates a disk cache in the directory, creating it if needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// Get the cached response for the key if it has not expired.
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	file := d.path(key)
+	header, value, err := readDiskCacheFile(file, true)
+	if err != nil || header.Key != key {
+		return nil, false
+	}
+
+	if time.Now().After(header.Expires) {
+		os.Remove(file)
+		return nil, false
+	}
+
+	return value, true
+}
+
+// GetStale gets the cached response for the key even if it has expired.
+func (d *DiskCache) GetStale(key string) ([]byte, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	header, value, err := readDiskCacheFile(d.path(key), true)
+	if err != nil || header.Key != key {
+		return nil, false
+	}
+
+	return value, true
+}
+
+// Set the response for the key, expiring after the ttl.
+func (d *DiskCache) Set(key string, value []byte, ttl time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	header, err := json.Marshal(diskCacheHeader{Key: key, Expires: time.Now().Add(ttl)})
+	if err != nil {
+		return
+	}
+
+	// Write to a temporary file first so readers never see a partial entry
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(append(append(header, '\n'), value...))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return
+	}
+
+	os.Rename(tmp.Name(), d.path(key))
+}
+
+// Delete the response for the key.
+func (d *DiskCache) Delete(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	os.Remove(d.path(key))
+}
+
+// Delete every response with a key starting with the prefix.
+func (d *DiskCache) DeletePrefix(prefix string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		file := filepath.Join(d.dir, entry.Name())
+		header, _, err := readDiskCacheFile(file, false)
+		if err == nil && strings.HasPrefix(header.Key, prefix) {
+			os.Remove(file)
+		}
+	}
+}
+
+// Get the file name for the key.
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:]))
+}
+
+// Read the header and optionally the value from a cache file.
+func readDiskCacheFile(file string, readValue bool) (diskCacheHeader, []byte, error) {
+	header := diskCacheHeader{}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return header, nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		return header, nil, err
+	}
+
+	if err := json.Unmarshal(line, &header); err != nil {
+		return header, nil, err
+	}
+
+	if !readValue {
+		return header, nil, nil
+	}
+
+	value, err := io.ReadAll(r)
+	return header, value, err
+}
@@ -0,0 +1,79 @@
+package sleeper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDiskCache(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create disk cache: %v", err)
+	}
+
+	cache.Set("http://localhost/v1/league/1", []byte(`{"league_id": "1"}`), time.Minute)
+	cache.Set("http://localhost/v1/league/1/rosters", []byte(`[]`), time.Minute)
+	cache.Set("http://localhost/v1/league/2", []byte(`{"league_id": "2"}`), time.Minute)
+	cache.Set("http://localhost/v1/state/nfl", []byte(`{}`), -time.Second)
+
+	if value, ok := cache.Get("http://localhost/v1/league/1"); !ok || string(value) != `{"league_id": "1"}` {
+		t.Errorf("Expected cached league 1, got %s (%v)", value, ok)
+	}
+	if value, ok := cache.GetStale("http://localhost/v1/state/nfl"); !ok || string(value) != `{}` {
+		t.Errorf("Expected stale sport state, got %s (%v)", value, ok)
+	}
+	if _, ok := cache.Get("http://localhost/v1/state/nfl"); ok {
+		t.Error("Expected sport state to be expired")
+	}
+
+	cache.DeletePrefix("http://localhost/v1/league/1/")
+	if _, ok := cache.Get("http://localhost/v1/league/1/rosters"); ok {
+		t.Error("Expected rosters to be deleted")
+	}
+	if _, ok := cache.Get("http://localhost/v1/league/1"); !ok {
+		t.Error("Expected league 1 to still be cached")
+	}
+
+	cache.Delete("http://localhost/v1/league/2")
+	if _, ok := cache.Get("http://localhost/v1/league/2"); ok {
+		t.Error("Expected league 2 to be deleted")
+	}
+}
+
+func TestDiskCacheSurvivesNewClient(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"week": 5}`))
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+	for i := 0; i < 2; i++ {
+		cache, err := NewDiskCache(dir)
+		if err != nil {
+			t.Fatalf("Failed to create disk cache: %v", err)
+		}
+
+		client := NewClientWithOptions(ClientOptions{
+			BaseURL: ts.URL,
+			Cache:   cache,
+		})
+
+		state, err := client.GetSportState("nfl")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if state.Week != 5 {
+			t.Errorf("Expected week 5, got %d", state.Week)
+		}
+	}
+
+	if n := hits.Load(); n != 1 {
+		t.Errorf("Expected 1 request, got %d", n)
+	}
+}
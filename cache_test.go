@@ -0,0 +1,123 @@
+package sleeper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	cache := NewMemoryCache(2)
+
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Minute)
+
+	// Reading a makes b the least recently used entry
+	if value, ok := cache.Get("a"); !ok || string(value) != "1" {
+		t.Errorf("Expected a=1, got %s (%v)", value, ok)
+	}
+
+	cache.Set("c", []byte("3"), time.Minute)
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected b to be evicted")
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Expected 2 entries, got %d", cache.Len())
+	}
+
+	cache.Set("d", []byte("4"), -time.Second)
+	if _, ok := cache.Get("d"); ok {
+		t.Error("Expected d to be expired")
+	}
+
+	cache.Set("prefix/1", []byte("1"), time.Minute)
+	cache.Set("prefix/2", []byte("2"), time.Minute)
+	cache.DeletePrefix("prefix/")
+	if _, ok := cache.Get("prefix/1"); ok {
+		t.Error("Expected prefix/1 to be deleted")
+	}
+
+	cache.Delete("c")
+	if _, ok := cache.Get("c"); ok {
+		t.Error("Expected c to be deleted")
+	}
+}
+
+func TestClientCache(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"league_id": "123"}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Cache:   NewMemoryCache(0),
+		CacheTTL: map[string]time.Duration{
+			EndpointMatchups: 0,
+		},
+	})
+
+	for i := 0; i < 3; i++ {
+		league, err := client.GetLeague("123")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if league.LeagueID != "123" {
+			t.Errorf("Expected league ID 123, got %s", league.LeagueID)
+		}
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("Expected 1 request for cached league, got %d", n)
+	}
+
+	// Matchups are not cached because the TTL is overridden to zero
+	client.GetMatchups("123", 1)
+	client.GetMatchups("123", 1)
+	if n := hits.Load(); n != 3 {
+		t.Errorf("Expected 3 requests, got %d", n)
+	}
+
+	// Invalidating another league with the same prefix keeps the entry
+	client.InvalidateLeague("12")
+	client.GetLeague("123")
+	if n := hits.Load(); n != 3 {
+		t.Errorf("Expected league to still be cached, got %d requests", n)
+	}
+
+	client.InvalidateLeague("123")
+	client.GetLeague("123")
+	if n := hits.Load(); n != 4 {
+		t.Errorf("Expected league to be fetched again, got %d requests", n)
+	}
+
+	client.ClearCache()
+	client.GetLeague("123")
+	if n := hits.Load(); n != 5 {
+		t.Errorf("Expected league to be fetched after clearing, got %d requests", n)
+	}
+}
+
+func TestClientCacheSkipsErrors(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Cache:   NewMemoryCache(10),
+	})
+
+	client.GetLeague("123")
+	client.GetLeague("123")
+	if n := hits.Load(); n != 2 {
+		t.Errorf("Expected errors not to be cached, got %d requests", n)
+	}
+}
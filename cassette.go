@@ -0,0 +1,198 @@
+package sleeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrCassetteMiss is returned in replay mode when a request has no recorded response.
+var ErrCassetteMiss = errors.New("sleeper: no recorded response in cassette")
+
+// CassetteMode selects whether a Cassette records or replays responses.
+type CassetteMode int
+
+const (
+	// CassetteRecord sends requests to the API and records every response.
+	CassetteRecord CassetteMode = iota + 1
+	// CassetteReplay serves recorded responses without using the network.
+	CassetteReplay
+)
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 []byte      `json:"body_base64,omitempty"` // Used instead of Body for binary responses such as avatars
+}
+
+// Cassette records responses to a file and replays them later for deterministic tests.
+//
+// Requests are matched on method, path and query, so a cassette recorded against
+// one base URL can be replayed against another. When the same request was recorded
+// more than once, the responses are replayed in order and the last one is repeated.
+type Cassette struct {
+	mu           sync.Mutex
+	path         string
+	mode         CassetteMode
+	interactions []Interaction
+	played       map[string]int
+}
+
+// NewCassette creates a cassette backed by the file. In replay mode the file is loaded
+// and must exist. In record mode the file is written when Save is called.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	cassette := &Cassette{
+		path:   path,
+		mode:   mode,
+		played: make(map[string]int),
+	}
+
+	switch mode {
+	case CassetteRecord:
+	case CassetteReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &cassette.interactions); err != nil {
+			return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("invalid cassette mode: %d", mode)
+	}
+
+	return cassette, nil
+}
+
+// Interactions returns a copy of the recorded interactions.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// Middleware returns the Middleware that records or replays requests. It is added
+// automatically when the cassette is set in ClientOptions.
+func (c *Cassette) Middleware() Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if c.mode == CassetteReplay {
+				return c.replay(req)
+			}
+			return c.record(req, next)
+		}
+	}
+}
+
+// Send the request and store the response.
+func (c *Cassette) record(req *http.Request, next RoundTripFunc) (*http.Response, error) {
+	resp, err := next(req)
+	if err != nil {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := Interaction{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+	}
+	if utf8.Valid(body) {
+		interaction.Body = string(body)
+	} else {
+		interaction.BodyBase64 = body
+	}
+
+	c.mu.Lock()
+	c.interactions = append(c.interactions, interaction)
+	c.mu.Unlock()
+
+	return resp, nil
+}
+
+// Find the next recorded response for the request.
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.RequestURI()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var matches []int
+	for i, interaction := range c.interactions {
+		if interaction.matches(req) {
+			matches = append(matches, i)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrCassetteMiss, key)
+	}
+
+	n := min(c.played[key], len(matches)-1)
+	c.played[key]++
+
+	interaction := c.interactions[matches[n]]
+	body := interaction.BodyBase64
+	if body == nil {
+		body = []byte(interaction.Body)
+	}
+
+	header := interaction.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// Check if the interaction was recorded for the same method, path and query.
+func (i Interaction) matches(req *http.Request) bool {
+	if i.Method != req.Method {
+		return false
+	}
+
+	recorded, err := req.URL.Parse(i.URL)
+	if err != nil {
+		return false
+	}
+
+	return recorded.Path == req.URL.Path && recorded.RawQuery == req.URL.RawQuery
+}
@@ -0,0 +1,187 @@
+package sleeper
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		switch r.URL.Path {
+		case "/v1/league/123":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"league_id": "123", "name": "Test League"}`))
+		case "/v1/state/nfl":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"week": 7}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := NewCassette(path, CassetteRecord)
+	if err != nil {
+		t.Fatalf("Failed to create cassette: %v", err)
+	}
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL:  ts.URL,
+		Cassette: recorder,
+	})
+
+	if _, err := client.GetLeague("123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.GetSportState("nfl"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.GetLeague("missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound, got %v", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Failed to save cassette: %v", err)
+	}
+	if len(recorder.Interactions()) != 3 {
+		t.Errorf("Expected 3 interactions, got %d", len(recorder.Interactions()))
+	}
+
+	// Replay with the server shut down so any network use fails
+	ts.Close()
+
+	player, err := NewCassette(path, CassetteReplay)
+	if err != nil {
+		t.Fatalf("Failed to load cassette: %v", err)
+	}
+
+	client = NewClientWithOptions(ClientOptions{
+		BaseURL:  "http://replay.invalid",
+		Cassette: player,
+	})
+
+	league, err := client.GetLeague("123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if league.Name != "Test League" {
+		t.Errorf("Expected league name Test League, got %s", league.Name)
+	}
+
+	state, err := client.GetSportState("nfl")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if state.Week != 7 {
+		t.Errorf("Expected week 7, got %d", state.Week)
+	}
+
+	if _, err := client.GetLeague("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected recorded ErrNotFound, got %v", err)
+	}
+
+	if _, err := client.GetRosters("123"); !errors.Is(err, ErrCassetteMiss) {
+		t.Errorf("Expected ErrCassetteMiss, got %v", err)
+	}
+
+	if n := hits.Load(); n != 3 {
+		t.Errorf("Expected 3 requests while recording only, got %d", n)
+	}
+}
+
+func TestCassetteReplayInOrder(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"week": 1}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"week": 2}`))
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, _ := NewCassette(path, CassetteRecord)
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, Cassette: recorder})
+	client.GetSportState("nfl")
+	client.GetSportState("nfl")
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Failed to save cassette: %v", err)
+	}
+
+	player, err := NewCassette(path, CassetteReplay)
+	if err != nil {
+		t.Fatalf("Failed to load cassette: %v", err)
+	}
+	client = NewClientWithOptions(ClientOptions{BaseURL: ts.URL, Cassette: player})
+
+	var weeks []int
+	for i := 0; i < 3; i++ {
+		state, err := client.GetSportState("nfl")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		weeks = append(weeks, state.Week)
+	}
+
+	if !slices.Equal(weeks, []int{1, 2, 2}) {
+		t.Errorf("Expected weeks [1 2 2], got %v", weeks)
+	}
+}
+
+func TestCassetteReplayScoreboards(t *testing.T) {
+	cassette, err := NewCassette(filepath.Join("testdata", "scoreboards_week1.json"), CassetteReplay)
+	if err != nil {
+		t.Fatalf("Failed to load cassette: %v", err)
+	}
+
+	client := NewClientWithOptions(ClientOptions{
+		Cassette: cassette,
+	})
+
+	scoreboards, err := client.GetScoreboards("289646328504385536", 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(scoreboards) != 2 {
+		t.Fatalf("Expected 2 scoreboards, got %d", len(scoreboards))
+	}
+
+	for _, sb := range scoreboards {
+		// The league plays the median each week
+		if sb.Median != MedianScore([]float32{112.4, 98.16, 130.02, 87.5}) || sb.MedianResult1 != ResultWin || sb.MedianResult2 != ResultLoss {
+			t.Errorf("Unexpected median results %+v", sb)
+		}
+
+		switch sb.Teamname1 {
+		case "Game of End Zones":
+			if sb.Teamname2 != "Saving Matt Ryan" || sb.Points1 != 112.4 || sb.Points2 != 98.16 {
+				t.Errorf("Unexpected scoreboard %+v", sb)
+			}
+		case "Team gridiron":
+			if sb.Teamname2 != "Bye Week" || sb.Points1 != 130.02 || sb.Points2 != 87.5 {
+				t.Errorf("Unexpected scoreboard %+v", sb)
+			}
+		default:
+			t.Errorf("Unexpected scoreboard %+v", sb)
+		}
+	}
+}
+
+func TestNewCassetteErrors(t *testing.T) {
+	if _, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), CassetteReplay); err == nil {
+		t.Error("Expected error for missing cassette file")
+	}
+	if _, err := NewCassette("cassette.json", CassetteMode(0)); err == nil {
+		t.Error("Expected error for invalid mode")
+	}
+}
@@ -1,15 +1,22 @@
 package sleeper
 
+import (
+	"context"
+	"log/slog"
+)
+
 type customTeamInfo struct {
-	DisplayName string
-	Losses      int
-	MatchupID   int
-	OwnerID     string
-	Points      float32
-	RosterID    int
-	Teamname    string
-	Week        int
-	Wins        int
+	DisplayName  string
+	Losses       int
+	MatchupID    int
+	Median       float32
+	MedianResult MatchResult
+	OwnerID      string
+	Points       float32
+	RosterID     int
+	Teamname     string
+	Week         int
+	Wins         int
 }
 
 type TeamMatchup struct {
@@ -19,13 +26,50 @@ type TeamMatchup struct {
 	Team2Losses int
 	Team1Wins   int
 	Team2Wins   int
+
+	// Results against the league median for the week, empty unless League.Settings.LeagueAverageMatch is set
+	Team1Median MatchResult
+	Team2Median MatchResult
 }
 
 // Get matchup information for the specified week.
 func (c *Client) GetTeamMatchups(league_id string, week int) ([]TeamMatchup, error) {
+	return c.GetTeamMatchupsContext(context.Background(), league_id, week)
+}
+
+// GetTeamMatchupsContext is like GetTeamMatchups but accepts a context.
+func (c *Client) GetTeamMatchupsContext(ctx context.Context, league_id string, week int) ([]TeamMatchup, error) {
+	return FetchTeamMatchups(ctx, c, league_id, week)
+}
+
+// Get matchup information for the specified week of a league you already have, without
+// fetching the league again.
+func (c *Client) GetTeamMatchupsForLeague(league League, week int) ([]TeamMatchup, error) {
+	return c.GetTeamMatchupsForLeagueContext(context.Background(), league, week)
+}
+
+// GetTeamMatchupsForLeagueContext is like GetTeamMatchupsForLeague but accepts a context.
+func (c *Client) GetTeamMatchupsForLeagueContext(ctx context.Context, league League, week int) ([]TeamMatchup, error) {
+	return FetchTeamMatchupsForLeague(ctx, c, league, week)
+}
+
+// FetchTeamMatchups gets the matchups for the week from the api and pairs each team with
+// its opponent and their wins and losses. A week of zero or less uses the current week.
+// Results against the league median are included when the league plays it.
+func FetchTeamMatchups(ctx context.Context, api LeagueAPI, league_id string, week int) ([]TeamMatchup, error) {
+	return fetchTeamMatchups(ctx, api, league_id, nil, week)
+}
+
+// FetchTeamMatchupsForLeague is like FetchTeamMatchups but uses the league instead of fetching it.
+func FetchTeamMatchupsForLeague(ctx context.Context, api LeagueAPI, league League, week int) ([]TeamMatchup, error) {
+	return fetchTeamMatchups(ctx, api, league.LeagueID, &league, week)
+}
+
+// Pair each team with its opponent, fetching the league when it is nil.
+func fetchTeamMatchups(ctx context.Context, api LeagueAPI, league_id string, league *League, week int) ([]TeamMatchup, error) {
 	var matchups []TeamMatchup
 
-	teaminfo, err := c.getFantasyInfo(league_id, week)
+	teaminfo, err := getFantasyInfo(ctx, api, league_id, league, week)
 	if err != nil {
 		return matchups, err
 	}
@@ -38,6 +82,7 @@ func (c *Client) GetTeamMatchups(league_id string, week int) ([]TeamMatchup, err
 			newteam.Teamname2 = team.Teamname
 			newteam.Team2Wins = team.Wins
 			newteam.Team2Losses = team.Losses
+			newteam.Team2Median = team.MedianResult
 			allmatchups[team.MatchupID] = newteam
 
 		} else {
@@ -45,6 +90,7 @@ func (c *Client) GetTeamMatchups(league_id string, week int) ([]TeamMatchup, err
 				Teamname1:   team.Teamname,
 				Team1Wins:   team.Wins,
 				Team1Losses: team.Losses,
+				Team1Median: team.MedianResult,
 			}
 			allmatchups[team.MatchupID] = mu
 		}
@@ -64,13 +110,51 @@ type Scoreboard struct {
 	Teamname2 string  `json:"teamname_2"`
 	Points1   float32 `json:"points_1"`
 	Points2   float32 `json:"points_2"`
+
+	// League median for the week and each team's result against it, empty unless League.Settings.LeagueAverageMatch is set
+	Median        float32     `json:"median,omitempty"`
+	MedianResult1 MatchResult `json:"median_result_1,omitempty"`
+	MedianResult2 MatchResult `json:"median_result_2,omitempty"`
 }
 
 // Get the scoreboard for each game for the specified week.
 func (c *Client) GetScoreboards(league_id string, week int) ([]Scoreboard, error) {
+	return c.GetScoreboardsContext(context.Background(), league_id, week)
+}
+
+// GetScoreboardsContext is like GetScoreboards but accepts a context.
+func (c *Client) GetScoreboardsContext(ctx context.Context, league_id string, week int) ([]Scoreboard, error) {
+	return FetchScoreboards(ctx, c, league_id, week)
+}
+
+// Get the scoreboard for each game for the specified week of a league you already have,
+// without fetching the league again.
+func (c *Client) GetScoreboardsForLeague(league League, week int) ([]Scoreboard, error) {
+	return c.GetScoreboardsForLeagueContext(context.Background(), league, week)
+}
+
+// GetScoreboardsForLeagueContext is like GetScoreboardsForLeague but accepts a context.
+func (c *Client) GetScoreboardsForLeagueContext(ctx context.Context, league League, week int) ([]Scoreboard, error) {
+	return FetchScoreboardsForLeague(ctx, c, league, week)
+}
+
+// FetchScoreboards gets the matchups for the week from the api and pairs each team with
+// its opponent and the points both scored. A week of zero or less uses the current week.
+// The league median is included when the league plays it.
+func FetchScoreboards(ctx context.Context, api LeagueAPI, league_id string, week int) ([]Scoreboard, error) {
+	return fetchScoreboards(ctx, api, league_id, nil, week)
+}
+
+// FetchScoreboardsForLeague is like FetchScoreboards but uses the league instead of fetching it.
+func FetchScoreboardsForLeague(ctx context.Context, api LeagueAPI, league League, week int) ([]Scoreboard, error) {
+	return fetchScoreboards(ctx, api, league.LeagueID, &league, week)
+}
+
+// Pair each team with its opponent and their points, fetching the league when it is nil.
+func fetchScoreboards(ctx context.Context, api LeagueAPI, league_id string, league *League, week int) ([]Scoreboard, error) {
 	var scoreboards []Scoreboard
 
-	teaminfo, err := c.getFantasyInfo(league_id, week)
+	teaminfo, err := getFantasyInfo(ctx, api, league_id, league, week)
 	if err != nil {
 		return scoreboards, err
 	}
@@ -82,12 +166,15 @@ func (c *Client) GetScoreboards(league_id string, week int) ([]Scoreboard, error
 			newteam := allscoreboards[team.MatchupID]
 			newteam.Teamname2 = team.Teamname
 			newteam.Points2 = team.Points
+			newteam.MedianResult2 = team.MedianResult
 			allscoreboards[team.MatchupID] = newteam
 
 		} else {
 			sb := Scoreboard{
-				Teamname1: team.Teamname,
-				Points1:   team.Points,
+				Teamname1:     team.Teamname,
+				Points1:       team.Points,
+				Median:        team.Median,
+				MedianResult1: team.MedianResult,
 			}
 			allscoreboards[team.MatchupID] = sb
 		}
@@ -103,43 +190,64 @@ func (c *Client) GetScoreboards(league_id string, week int) ([]Scoreboard, error
 	return scoreboards, nil
 }
 
-// Sends multiple API requests to get information for matchups, records, and scoreboard in order to correlate the data into one structure
-func (c *Client) getFantasyInfo(league_id string, week int) ([]customTeamInfo, error) {
+// Sends multiple API requests to get information for matchups, records, and scoreboard in order to correlate the data into one structure.
+// The league is fetched when it is nil.
+func getFantasyInfo(ctx context.Context, api LeagueAPI, league_id string, league *League, week int) ([]customTeamInfo, error) {
 	var customInfo []customTeamInfo
 	matchupWeek := week
 
-	if week <= 0 {
-		league, err := c.GetLeague(league_id)
+	c := clientOf(api)
+
+	// Get the league for its sport and whether it plays the median. Client requests for it
+	// are cached and coalesced like any other request.
+	if league == nil {
+		l, err := api.GetLeagueContext(ctx, league_id)
 		if err != nil {
 			return customInfo, err
 		}
+		league = &l
+	}
 
-		sportstate, err := c.GetSportState(league.Sport)
+	if week <= 0 {
+		current, err := currentWeek(ctx, api, league.Sport)
 		if err != nil {
 			return customInfo, err
 		}
+		matchupWeek = current
 
-		matchupWeek = sportstate.Week
-		if sportstate.SeasonType == "pre" {
-			matchupWeek = 1
-		}
-
+		c.debug(ctx, "sleeper: resolved current week",
+			slog.String("league_id", league_id),
+			slog.String("sport", league.Sport.String()),
+			slog.Int("week", matchupWeek),
+		)
 	}
 
 	// Get the matchups in the league
-	matchups, err := c.GetMatchups(league_id, matchupWeek)
+	matchups, err := api.GetMatchupsContext(ctx, league_id, matchupWeek)
 	if err != nil {
 		return customInfo, err
 	}
 
+	// Get each team's result against the median in leagues that play it
+	median, medianResult, hasMedian := float32(0), map[int]MatchResult(nil), false
+	if league.Settings.LeagueAverageMatch != 0 {
+		median, medianResult, hasMedian = medianResults(matchups)
+		c.debug(ctx, "sleeper: computed league median",
+			slog.String("league_id", league_id),
+			slog.Int("week", matchupWeek),
+			slog.Bool("scored", hasMedian),
+			slog.Float64("median", float64(median)),
+		)
+	}
+
 	// Get the rosters in the league
-	rosters, err := c.GetRosters(league_id)
+	rosters, err := api.GetRostersContext(ctx, league_id)
 	if err != nil {
 		return customInfo, err
 	}
 
 	// Get the users in the league
-	users, err := c.GetLeagueUsers(league_id)
+	users, err := api.GetLeagueUsersContext(ctx, league_id)
 	if err != nil {
 		return customInfo, err
 	}
@@ -156,29 +264,67 @@ func (c *Client) getFantasyInfo(league_id string, week int) ([]customTeamInfo, e
 
 		if user.Metadata.TeamName == "" {
 			newuser.Teamname = "Team " + user.DisplayName
+			c.debug(ctx, "sleeper: user has no team name, using display name",
+				slog.String("league_id", league_id),
+				c.sensitiveAttr("user_id", user.UserID),
+				c.sensitiveAttr("display_name", user.DisplayName),
+			)
 		}
 
 		// Loop through rosters
+		foundRoster := false
 		for _, roster := range rosters {
 			if roster.OwnerID == newuser.OwnerID {
 				newuser.Wins = roster.Settings.Wins
 				newuser.Losses = roster.Settings.Losses
 				newuser.RosterID = roster.RosterID
+				foundRoster = true
 				break
 			}
 		}
 
 		// Loop through matchups
+		foundMatchup := false
 		for _, matchup := range matchups {
 			if matchup.RosterID == newuser.RosterID {
 				newuser.MatchupID = matchup.MatchupID
 				newuser.Points = matchup.Points
+				foundMatchup = true
 				break
 			}
 		}
 
+		if hasMedian {
+			newuser.Median = median
+			newuser.MedianResult = medianResult[newuser.RosterID]
+		}
+
+		c.debug(ctx, "sleeper: correlated team",
+			slog.String("league_id", league_id),
+			slog.Int("week", matchupWeek),
+			c.sensitiveAttr("user_id", user.UserID),
+			c.sensitiveAttr("team_name", newuser.Teamname),
+			slog.Int("roster_id", newuser.RosterID),
+			slog.Bool("found_roster", foundRoster),
+			slog.Int("matchup_id", newuser.MatchupID),
+			slog.Bool("found_matchup", foundMatchup),
+		)
+
 		customInfo = append(customInfo, newuser)
 	}
 
 	return customInfo, nil
 }
+
+// Get the week in progress for the sport, or week 1 before the season starts.
+func currentWeek(ctx context.Context, api LeagueAPI, sport Sport) (int, error) {
+	state, err := api.GetSportStateContext(ctx, sport)
+	if err != nil {
+		return 0, err
+	}
+
+	if state.SeasonType == "pre" {
+		return 1, nil
+	}
+	return state.Week, nil
+}
@@ -63,6 +63,7 @@ func TestGetTeamMatchups(t *testing.T) {
 			RosterID: 1,
 			OwnerID:  "1",
 			Settings: struct {
+				Division         int `json:"division"`
 				Fpts             int `json:"fpts"`
 				Losses           int `json:"losses"`
 				Ties             int `json:"ties"`
@@ -79,6 +80,7 @@ func TestGetTeamMatchups(t *testing.T) {
 			RosterID: 2,
 			OwnerID:  "2",
 			Settings: struct {
+				Division         int `json:"division"`
 				Fpts             int `json:"fpts"`
 				Losses           int `json:"losses"`
 				Ties             int `json:"ties"`
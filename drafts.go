@@ -1,6 +1,7 @@
 package sleeper
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -47,10 +48,13 @@ type Draft struct {
 	Season         string      `json:"season"`
 	SeasonType     string      `json:"season_type"`
 	SlotToRosterID interface{} `json:"slot_to_roster_id"`
-	Sport          string      `json:"sport"`
+	Sport          Sport       `json:"sport"`
 	StartTime      int64       `json:"start_time"`
 	Status         string      `json:"status"`
 	Type           string      `json:"type"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type DraftPlayer struct {
@@ -75,6 +79,9 @@ type DraftPlayer struct {
 	IsKeeper  interface{} `json:"is_keeper"`
 	DraftSlot int         `json:"draft_slot"`
 	DraftID   string      `json:"draft_id"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type TradedPick struct {
@@ -83,11 +90,19 @@ type TradedPick struct {
 	Round           int    `json:"round"`
 	RosterID        int    `json:"roster_id"`
 	Season          string `json:"season"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 // Get all drafts by a user.
 // (GET `https://api.sleeper.app/v1/user/<user_id>/drafts/<sport>/<season>`)
-func (c *Client) GetDraftsForUser(user_id string, sport string, season int) ([]Draft, error) {
+func (c *Client) GetDraftsForUser(user_id string, sport Sport, season int) ([]Draft, error) {
+	return c.GetDraftsForUserContext(context.Background(), user_id, sport, season)
+}
+
+// GetDraftsForUserContext is like GetDraftsForUser but accepts a context.
+func (c *Client) GetDraftsForUserContext(ctx context.Context, user_id string, sport Sport, season int) ([]Draft, error) {
 	drafts := []Draft{}
 
 	// Sleeper only has data from 2009 to present
@@ -95,14 +110,18 @@ func (c *Client) GetDraftsForUser(user_id string, sport string, season int) ([]D
 		return drafts, errors.New("invalid year - must be between 2008 and current")
 	}
 
+	if err := sport.Validate(); err != nil {
+		return drafts, err
+	}
+
 	url := fmt.Sprintf("%s/v1/user/%s/drafts/%s/%d", c.sleeperURL, user_id, sport, season)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return drafts, err
 	}
 
-	err = json.Unmarshal(data, &drafts)
+	err = c.decodeJSON(ctx, url, data, &drafts)
 
 	return drafts, err
 }
@@ -110,16 +129,21 @@ func (c *Client) GetDraftsForUser(user_id string, sport string, season int) ([]D
 // Get all drafts for a league.
 // (GET `https://api.sleeper.app/v1/league/<league_id>/drafts`)
 func (c *Client) GetDraftsForLeague(league_id string) ([]Draft, error) {
+	return c.GetDraftsForLeagueContext(context.Background(), league_id)
+}
+
+// GetDraftsForLeagueContext is like GetDraftsForLeague but accepts a context.
+func (c *Client) GetDraftsForLeagueContext(ctx context.Context, league_id string) ([]Draft, error) {
 	drafts := []Draft{}
 
 	url := fmt.Sprintf("%s/v1/league/%s/drafts", c.sleeperURL, league_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return drafts, err
 	}
 
-	err = json.Unmarshal(data, &drafts)
+	err = c.decodeJSON(ctx, url, data, &drafts)
 
 	return drafts, err
 }
@@ -127,16 +151,21 @@ func (c *Client) GetDraftsForLeague(league_id string) ([]Draft, error) {
 // Get a specific draft.
 // (GET `https://api.sleeper.app/v1/draft/<draft_id>`)
 func (c *Client) GetDraft(draft_id string) (Draft, error) {
+	return c.GetDraftContext(context.Background(), draft_id)
+}
+
+// GetDraftContext is like GetDraft but accepts a context.
+func (c *Client) GetDraftContext(ctx context.Context, draft_id string) (Draft, error) {
 	draft := Draft{}
 
 	url := fmt.Sprintf("%s/v1/draft/%s", c.sleeperURL, draft_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return draft, err
 	}
 
-	err = json.Unmarshal(data, &draft)
+	err = c.decodeJSON(ctx, url, data, &draft)
 
 	return draft, err
 }
@@ -144,16 +173,21 @@ func (c *Client) GetDraft(draft_id string) (Draft, error) {
 // Get all picks in a draft.
 // (GET `https://api.sleeper.app/v1/draft/<draft_id>/picks`)
 func (c *Client) GetAllDraftPicks(draft_id string) ([]DraftPlayer, error) {
+	return c.GetAllDraftPicksContext(context.Background(), draft_id)
+}
+
+// GetAllDraftPicksContext is like GetAllDraftPicks but accepts a context.
+func (c *Client) GetAllDraftPicksContext(ctx context.Context, draft_id string) ([]DraftPlayer, error) {
 	draftPlayers := []DraftPlayer{}
 
 	url := fmt.Sprintf("%s/v1/draft/%s/picks", c.sleeperURL, draft_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return draftPlayers, err
 	}
 
-	err = json.Unmarshal(data, &draftPlayers)
+	err = c.decodeJSON(ctx, url, data, &draftPlayers)
 
 	return draftPlayers, err
 }
@@ -161,16 +195,21 @@ func (c *Client) GetAllDraftPicks(draft_id string) ([]DraftPlayer, error) {
 // Get all traded picks in a draft.
 // (GET `https://api.sleeper.app/v1/draft/<draft_id>/traded_picks`)
 func (c *Client) GetDraftTradedPicks(draft_id string) ([]TradedPick, error) {
+	return c.GetDraftTradedPicksContext(context.Background(), draft_id)
+}
+
+// GetDraftTradedPicksContext is like GetDraftTradedPicks but accepts a context.
+func (c *Client) GetDraftTradedPicksContext(ctx context.Context, draft_id string) ([]TradedPick, error) {
 	tradedPicks := []TradedPick{}
 
 	url := fmt.Sprintf("%s/v1/draft/%s/traded_picks", c.sleeperURL, draft_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return tradedPicks, err
 	}
 
-	err = json.Unmarshal(data, &tradedPicks)
+	err = c.decodeJSON(ctx, url, data, &tradedPicks)
 
 	return tradedPicks, err
 }
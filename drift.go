@@ -0,0 +1,353 @@
+package sleeper
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DriftKind is the kind of difference found between a response and the model it is decoded into.
+type DriftKind string
+
+const (
+	DriftUnknownField DriftKind = "unknown_field" // The response has a field the model does not declare
+	DriftTypeMismatch DriftKind = "type_mismatch" // A field has a different JSON type than the model declares
+)
+
+// DriftIssue is a difference between the responses from an endpoint and the model they are
+// decoded into. Paths use "[]" for the elements of arrays and "*" for the values of objects
+// decoded into maps, such as "[].settings.fpts" or "*.new_field".
+type DriftIssue struct {
+	Endpoint  string    // Endpoint template such as /v1/league/:league_id/rosters
+	Kind      DriftKind // Kind of difference
+	Path      string    // Path to the field in the response
+	Expected  string    // Go type declared on the model, empty for unknown fields
+	Got       string    // JSON type in the response: object, array, string, number, or bool
+	Count     int       // Number of times the issue was seen
+	FirstSeen time.Time // Time the issue was first seen
+	LastSeen  time.Time // Time the issue was last seen
+}
+
+// DriftReport collects the differences between Sleeper's responses and the models,
+// grouped by endpoint. It is safe for concurrent use.
+type DriftReport struct {
+	mu     sync.Mutex
+	issues map[driftKey]*DriftIssue
+	now    func() time.Time
+}
+
+type driftKey struct {
+	endpoint string
+	kind     DriftKind
+	path     string
+}
+
+func newDriftReport() *DriftReport {
+	return &DriftReport{
+		issues: make(map[driftKey]*DriftIssue),
+		now:    time.Now,
+	}
+}
+
+// Issues returns every issue ordered by endpoint and path.
+func (r *DriftReport) Issues() []DriftIssue {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	issues := make([]DriftIssue, 0, len(r.issues))
+	for _, issue := range r.issues {
+		issues = append(issues, *issue)
+	}
+
+	slices.SortFunc(issues, func(a, b DriftIssue) int {
+		return cmp.Or(
+			cmp.Compare(a.Endpoint, b.Endpoint),
+			cmp.Compare(a.Path, b.Path),
+			cmp.Compare(a.Kind, b.Kind),
+		)
+	})
+
+	return issues
+}
+
+// Endpoints returns the issues grouped by endpoint template.
+func (r *DriftReport) Endpoints() map[string][]DriftIssue {
+	endpoints := make(map[string][]DriftIssue)
+	for _, issue := range r.Issues() {
+		endpoints[issue.Endpoint] = append(endpoints[issue.Endpoint], issue)
+	}
+	return endpoints
+}
+
+// Len returns the number of distinct issues.
+func (r *DriftReport) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.issues)
+}
+
+// Reset removes every issue from the report.
+func (r *DriftReport) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clear(r.issues)
+}
+
+// Add an issue to the report, returning true the first time it is seen.
+func (r *DriftReport) add(issue DriftIssue) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	key := driftKey{endpoint: issue.Endpoint, kind: issue.Kind, path: issue.Path}
+	if existing, ok := r.issues[key]; ok {
+		existing.Count++
+		existing.LastSeen = now
+		existing.Got = issue.Got
+		return false
+	}
+
+	issue.Count = 1
+	issue.FirstSeen = now
+	issue.LastSeen = now
+	r.issues[key] = &issue
+	return true
+}
+
+// DriftReport returns the report of differences between responses and models found in
+// strict decoding mode, or nil if strict decoding is not enabled.
+func (c *Client) DriftReport() *DriftReport {
+	return c.drift
+}
+
+// Compare the response with the model v points to and add any differences to the drift
+// report. Values with the wrong type are replaced with null so the rest of the response
+// still decodes, otherwise the response is returned unchanged.
+func (c *Client) checkDrift(ctx context.Context, url string, data []byte, v any) []byte {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return data
+	}
+
+	w := driftWalker{}
+	doc = w.walk(doc, reflect.TypeOf(v).Elem(), "")
+
+	endpoint := c.endpoint(url)
+	for _, issue := range w.issues {
+		issue.Endpoint = endpoint
+		if c.drift.add(issue) {
+			c.debug(ctx, "sleeper: response does not match model",
+				c.urlAttr(url),
+				slog.String("endpoint", endpoint),
+				slog.String("kind", string(issue.Kind)),
+				slog.String("path", issue.Path),
+				slog.String("expected", issue.Expected),
+				slog.String("got", issue.Got),
+			)
+		}
+	}
+
+	if !w.mismatch {
+		return data
+	}
+
+	sanitized, err := json.Marshal(doc)
+	if err != nil {
+		return data
+	}
+	return sanitized
+}
+
+var rawMessageType = reflect.TypeFor[json.RawMessage]()
+
+// Set the Raw field of every model in v back to the part of data it came from after v
+// was decoded from the copy returned by checkDrift.
+func restoreRaw(data []byte, v reflect.Value) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return
+		}
+		if raw := v.FieldByName("Raw"); raw.IsValid() && raw.Type() == rawMessageType && raw.Len() > 0 {
+			raw.SetBytes(bytes.Clone(data))
+		}
+
+		byName := make(map[string]json.RawMessage, len(obj))
+		for key, value := range obj {
+			byName[strings.ToLower(key)] = value
+		}
+		for i := range v.NumField() {
+			f := v.Type().Field(i)
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if !f.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			if value, ok := byName[strings.ToLower(name)]; ok {
+				restoreRaw(value, v.Field(i))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		var arr []json.RawMessage
+		if err := json.Unmarshal(data, &arr); err != nil || len(arr) != v.Len() {
+			return
+		}
+		for i, value := range arr {
+			restoreRaw(value, v.Index(i))
+		}
+	case reflect.Map:
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(data, &obj); err != nil || v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		// Map values cannot be changed in place, so each one is copied and stored again
+		for key, value := range obj {
+			k := reflect.ValueOf(key).Convert(v.Type().Key())
+			elem := v.MapIndex(k)
+			if !elem.IsValid() {
+				continue
+			}
+			copied := reflect.New(elem.Type()).Elem()
+			copied.Set(elem)
+			restoreRaw(value, copied)
+			v.SetMapIndex(k, copied)
+		}
+	}
+}
+
+// Walks a decoded JSON document alongside the Go type it is decoded into.
+type driftWalker struct {
+	issues   []DriftIssue
+	seen     map[driftKey]bool
+	mismatch bool
+}
+
+func (w *driftWalker) report(kind DriftKind, path string, t reflect.Type, value any) {
+	key := driftKey{kind: kind, path: path}
+	if w.seen[key] {
+		return
+	}
+	if w.seen == nil {
+		w.seen = make(map[driftKey]bool)
+	}
+	w.seen[key] = true
+
+	issue := DriftIssue{Kind: kind, Path: path, Got: jsonType(value)}
+	if t != nil {
+		issue.Expected = t.String()
+	}
+	w.issues = append(w.issues, issue)
+}
+
+// Check the value against the type and return the value to decode, which is nil when
+// the types do not match.
+func (w *driftWalker) walk(value any, t reflect.Type, path string) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if value == nil || t.Kind() == reflect.Interface {
+		return value
+	}
+
+	ok := true
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, isObj := value.(map[string]any)
+		if ok = isObj; ok {
+			known := knownFields(t)
+			for key, field := range obj {
+				ft, declared := known[strings.ToLower(key)]
+				if !declared {
+					w.report(DriftUnknownField, joinPath(path, key), nil, field)
+					continue
+				}
+				obj[key] = w.walk(field, ft, joinPath(path, key))
+			}
+		}
+	case reflect.Map:
+		obj, isObj := value.(map[string]any)
+		if ok = isObj; ok {
+			for key, field := range obj {
+				obj[key] = w.walk(field, t.Elem(), joinPath(path, "*"))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		arr, isArr := value.([]any)
+		if ok = isArr; ok {
+			for i, elem := range arr {
+				arr[i] = w.walk(elem, t.Elem(), path+"[]")
+			}
+		}
+	case reflect.String:
+		_, ok = value.(string)
+	case reflect.Bool:
+		_, ok = value.(bool)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, isNum := value.(json.Number)
+		if ok = isNum; ok {
+			_, err := strconv.ParseInt(string(n), 10, t.Bits())
+			ok = err == nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, isNum := value.(json.Number)
+		if ok = isNum; ok {
+			_, err := strconv.ParseUint(string(n), 10, t.Bits())
+			ok = err == nil
+		}
+	case reflect.Float32, reflect.Float64:
+		_, ok = value.(json.Number)
+	}
+
+	if !ok {
+		w.report(DriftTypeMismatch, path, t, value)
+		w.mismatch = true
+		return nil
+	}
+	return value
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// Get the name of the JSON type of a decoded value.
+func jsonType(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "bool"
+	}
+	return "null"
+}
@@ -0,0 +1,142 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStrictDecodingReportsDrift(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"roster_id":1,"owner_id":"a","settings":{"wins":3,"fpts":"1200","fpts_total":1200.5},"new_field":true},
+			{"roster_id":"2","owner_id":"b","settings":{"wins":1,"fpts":900}}
+		]`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, StrictDecoding: true})
+
+	rosters, err := client.GetRosters("123")
+	if err != nil {
+		t.Fatalf("Expected no error in strict mode, got %v", err)
+	}
+
+	if len(rosters) != 2 {
+		t.Fatalf("Expected 2 rosters, got %d", len(rosters))
+	}
+	if rosters[0].Settings.Wins != 3 || rosters[0].Settings.Fpts != 0 || rosters[0].OwnerID != "a" {
+		t.Errorf("Expected mismatched fpts to be zero and other fields to decode, got %+v", rosters[0].Settings)
+	}
+	if rosters[1].RosterID != 0 || rosters[1].Settings.Fpts != 900 {
+		t.Errorf("Expected mismatched roster_id to be zero and other fields to decode, got %+v", rosters[1])
+	}
+
+	want := []DriftIssue{
+		{Endpoint: EndpointRosters, Kind: DriftUnknownField, Path: "[].new_field", Got: "bool"},
+		{Endpoint: EndpointRosters, Kind: DriftTypeMismatch, Path: "[].roster_id", Expected: "int", Got: "string"},
+		{Endpoint: EndpointRosters, Kind: DriftTypeMismatch, Path: "[].settings.fpts", Expected: "int", Got: "string"},
+		{Endpoint: EndpointRosters, Kind: DriftUnknownField, Path: "[].settings.fpts_total", Got: "number"},
+	}
+
+	issues := client.DriftReport().Issues()
+	if len(issues) != len(want) {
+		t.Fatalf("Expected %d issues, got %d: %+v", len(want), len(issues), issues)
+	}
+	for i, w := range want {
+		got := issues[i]
+		if got.Endpoint != w.Endpoint || got.Kind != w.Kind || got.Path != w.Path || got.Expected != w.Expected || got.Got != w.Got {
+			t.Errorf("Expected issue %+v, got %+v", w, got)
+		}
+		if got.Count != 1 || got.FirstSeen.IsZero() {
+			t.Errorf("Expected issue to be seen once, got %+v", got)
+		}
+	}
+
+	// Issues are counted once per response
+	if _, err := client.GetRosters("123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if issues := client.DriftReport().Issues(); issues[0].Count != 2 {
+		t.Errorf("Expected count 2, got %d", issues[0].Count)
+	}
+
+	if endpoints := client.DriftReport().Endpoints(); len(endpoints[EndpointRosters]) != 4 {
+		t.Errorf("Expected 4 issues for %s, got %+v", EndpointRosters, endpoints)
+	}
+
+	client.DriftReport().Reset()
+	if n := client.DriftReport().Len(); n != 0 {
+		t.Errorf("Expected empty report after reset, got %d", n)
+	}
+}
+
+func TestStrictDecodingMaps(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"1":{"player_id":"1","team":"BUF","new_id":5},"2":{"player_id":"2","espn_id":"abc"}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, StrictDecoding: true})
+
+	players, err := client.GetAllPlayers("nfl")
+	if err != nil {
+		t.Fatalf("Expected no error in strict mode, got %v", err)
+	}
+	if players["1"].Team != "BUF" || players["2"].PlayerID != "2" {
+		t.Errorf("Expected players to decode, got %+v", players)
+	}
+
+	issues := client.DriftReport().Issues()
+	if len(issues) != 2 || issues[0].Path != "*.espn_id" || issues[1].Path != "*.new_id" {
+		t.Errorf("Expected issues for *.espn_id and *.new_id, got %+v", issues)
+	}
+}
+
+func TestLenientDecodingFailsOnMismatch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"roster_id":"1"}]`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL})
+
+	_, err := client.GetRosters("123")
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Errorf("Expected *json.UnmarshalTypeError, got %v", err)
+	}
+	if client.DriftReport() != nil {
+		t.Error("Expected no drift report when strict decoding is disabled")
+	}
+}
+
+func TestStrictDecodingKeepsRaw(t *testing.T) {
+	body := `[{"roster_id":1,"owner_id":"a","settings":{"wins":3,"fpts":"1200"},"new_field":true},{"roster_id":2,"owner_id":"b"}]`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, StrictDecoding: true})
+
+	rosters, err := client.GetRosters("123")
+	if err != nil {
+		t.Fatalf("Expected no error in strict mode, got %v", err)
+	}
+
+	var want []json.RawMessage
+	if err := json.Unmarshal([]byte(body), &want); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for i, roster := range rosters {
+		if string(roster.Raw) != string(want[i]) {
+			t.Errorf("Expected raw %s, got %s", want[i], roster.Raw)
+		}
+	}
+	if string(rosters[0].Unknown["new_field"]) != "true" || rosters[0].Settings.Fpts != 0 {
+		t.Errorf("Expected unknown field and zero fpts, got %+v", rosters[0])
+	}
+}
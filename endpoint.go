@@ -0,0 +1,108 @@
+package sleeper
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Endpoint templates for every route called by the client. Segments starting
+// with a colon match any value.
+const (
+	EndpointUser              = "/v1/user/:user"
+	EndpointUserLeagues       = "/v1/user/:user_id/leagues/:sport/:season"
+	EndpointUserDrafts        = "/v1/user/:user_id/drafts/:sport/:season"
+	EndpointLeague            = "/v1/league/:league_id"
+	EndpointRosters           = "/v1/league/:league_id/rosters"
+	EndpointLeagueUsers       = "/v1/league/:league_id/users"
+	EndpointMatchups          = "/v1/league/:league_id/matchups/:week"
+	EndpointWinnersBracket    = "/v1/league/:league_id/winners_bracket"
+	EndpointLosersBracket     = "/v1/league/:league_id/losers_bracket"
+	EndpointTransactions      = "/v1/league/:league_id/transactions/:round"
+	EndpointLeagueTradedPicks = "/v1/league/:league_id/traded_picks"
+	EndpointLeagueDrafts      = "/v1/league/:league_id/drafts"
+	EndpointSportState        = "/v1/state/:sport"
+	EndpointDraft             = "/v1/draft/:draft_id"
+	EndpointDraftPicks        = "/v1/draft/:draft_id/picks"
+	EndpointDraftTradedPicks  = "/v1/draft/:draft_id/traded_picks"
+	EndpointPlayers           = "/v1/players/:sport"
+	EndpointTrendingPlayers   = "/v1/players/:sport/trending/:type"
+	EndpointPlayer            = "/player/:sport/:player_id"
+	EndpointPlayerResearch    = "/players/:sport/research/:season_type/:season/:week"
+	EndpointPlayerStats       = "/stats/:sport/player/:player_id"
+	EndpointProjections       = "/projections/:sport/:season/:week"
+	EndpointSchedule          = "/schedule/:sport/:season_type/:season"
+	EndpointNflTeamDepthChart = "/players/nfl/:team/depth_chart"
+	EndpointAvatar            = "/avatars/:avatar_id"
+	EndpointAvatarThumbnail   = "/avatars/thumbs/:avatar_id"
+	endpointUnknown           = "unknown"
+)
+
+var endpointTemplates = []string{
+	EndpointUser,
+	EndpointUserLeagues,
+	EndpointUserDrafts,
+	EndpointLeague,
+	EndpointRosters,
+	EndpointLeagueUsers,
+	EndpointMatchups,
+	EndpointWinnersBracket,
+	EndpointLosersBracket,
+	EndpointTransactions,
+	EndpointLeagueTradedPicks,
+	EndpointLeagueDrafts,
+	EndpointSportState,
+	EndpointDraft,
+	EndpointDraftPicks,
+	EndpointDraftTradedPicks,
+	EndpointPlayers,
+	EndpointTrendingPlayers,
+	EndpointPlayer,
+	EndpointPlayerResearch,
+	EndpointPlayerStats,
+	EndpointProjections,
+	EndpointSchedule,
+	EndpointNflTeamDepthChart,
+	EndpointAvatarThumbnail,
+	EndpointAvatar,
+}
+
+// Get the endpoint template for a request URL made by the client.
+func (c *Client) endpoint(rawURL string) string {
+	path := ""
+	if strings.HasPrefix(rawURL, c.sleeperURL) {
+		path = strings.TrimPrefix(rawURL, c.sleeperURL)
+	} else if strings.HasPrefix(rawURL, avatarBaseURL) {
+		path = "/avatars" + strings.TrimPrefix(rawURL, avatarBaseURL)
+	} else if u, err := url.Parse(rawURL); err == nil {
+		path = u.Path
+	}
+
+	path, _, _ = strings.Cut(path, "?")
+	return matchEndpoint(path)
+}
+
+// Match a URL path against the known endpoint templates.
+func matchEndpoint(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for _, template := range endpointTemplates {
+		parts := strings.Split(strings.Trim(template, "/"), "/")
+		if len(parts) != len(segments) {
+			continue
+		}
+
+		matched := true
+		for i, part := range parts {
+			if !strings.HasPrefix(part, ":") && part != segments[i] {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return template
+		}
+	}
+
+	return endpointUnknown
+}
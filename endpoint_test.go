@@ -0,0 +1,38 @@
+package sleeper
+
+import "testing"
+
+func TestEndpoint(t *testing.T) {
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: "http://localhost:8080",
+	})
+
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{"http://localhost:8080/v1/user/sleeperuser", EndpointUser},
+		{"http://localhost:8080/v1/user/123/leagues/nfl/2024", EndpointUserLeagues},
+		{"http://localhost:8080/v1/league/123", EndpointLeague},
+		{"http://localhost:8080/v1/league/123/matchups/5", EndpointMatchups},
+		{"http://localhost:8080/v1/league/123/transactions/2", EndpointTransactions},
+		{"http://localhost:8080/v1/players/nfl", EndpointPlayers},
+		{"http://localhost:8080/v1/players/nfl/trending/add?loopback_hours=24&limit=25", EndpointTrendingPlayers},
+		{"http://localhost:8080/players/nfl/research/regular/2024/1", EndpointPlayerResearch},
+		{"http://localhost:8080/players/nfl/BUF/depth_chart", EndpointNflTeamDepthChart},
+		{"http://localhost:8080/stats/nfl/player/4046?season_type=regular&season=2024", EndpointPlayerStats},
+		{"http://localhost:8080/schedule/nfl/post/2024", EndpointSchedule},
+		{"http://localhost:8080/schedule/nba/regular/2024", EndpointSchedule},
+		{"http://localhost:8080/projections/nba/2024/3?season_type=regular", EndpointProjections},
+		{"http://localhost:8080/player/lcs/123", EndpointPlayer},
+		{"https://sleepercdn.com/avatars/abc", EndpointAvatar},
+		{"https://sleepercdn.com/avatars/thumbs/abc", EndpointAvatarThumbnail},
+		{"http://localhost:8080/v2/something", endpointUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := client.endpoint(tt.url); got != tt.expected {
+			t.Errorf("endpoint(%s) = %s, expected %s", tt.url, got, tt.expected)
+		}
+	}
+}
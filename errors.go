@@ -0,0 +1,73 @@
+package sleeper
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Maximum number of bytes of the response body kept in an APIError.
+const maxErrorBodySize = 512
+
+var (
+	// ErrNotFound is returned when the requested resource does not exist (HTTP 404).
+	ErrNotFound = errors.New("sleeper: not found")
+	// ErrRateLimited is returned when Sleeper is rate limiting the client (HTTP 429).
+	ErrRateLimited = errors.New("sleeper: rate limited")
+	// ErrServerError is returned when Sleeper fails to handle the request (HTTP 5xx).
+	ErrServerError = errors.New("sleeper: server error")
+)
+
+// APIError is returned when the Sleeper API responds with a non-200 status code.
+// Use errors.Is with ErrNotFound, ErrRateLimited or ErrServerError to check for
+// common failures, or errors.As to inspect the response.
+type APIError struct {
+	StatusCode int           // HTTP status code of the response
+	URL        string        // URL that was requested
+	Body       string        // Beginning of the response body
+	RetryAfter time.Duration // Parsed Retry-After header, zero if not sent
+}
+
+func (e *APIError) Error() string {
+	if val, ok := errorCodes[e.StatusCode]; ok {
+		return fmt.Sprintf("web request error: %d %s", e.StatusCode, val)
+	}
+	return fmt.Sprintf("web request error: %d", e.StatusCode)
+}
+
+// Is reports whether the error matches one of the sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= 500 && e.StatusCode <= 599
+	}
+	return false
+}
+
+// Parse a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
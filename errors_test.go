@@ -0,0 +1,135 @@
+package sleeper
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		retryAfter string
+		sentinel   error
+		wantRetry  time.Duration
+		wantString string
+	}{
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			sentinel:   ErrNotFound,
+			wantString: "web request error: 404 Not Found",
+		},
+		{
+			name:       "rate limited",
+			statusCode: http.StatusTooManyRequests,
+			retryAfter: "30",
+			sentinel:   ErrRateLimited,
+			wantRetry:  30 * time.Second,
+			wantString: "web request error: 429 Too Many Requests",
+		},
+		{
+			name:       "service unavailable",
+			statusCode: http.StatusServiceUnavailable,
+			sentinel:   ErrServerError,
+			wantString: "web request error: 503 Service Unavailable",
+		},
+		{
+			name:       "unknown server error",
+			statusCode: http.StatusBadGateway,
+			sentinel:   ErrServerError,
+			wantString: "web request error: 502",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(`{"error": "details"}`))
+			}))
+			defer ts.Close()
+
+			client := NewClientWithOptions(ClientOptions{
+				BaseURL: ts.URL,
+			})
+
+			_, err := client.GetLeague("123")
+			if !errors.Is(err, tt.sentinel) {
+				t.Fatalf("Expected errors.Is(%v), got %v", tt.sentinel, err)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected *APIError, got %T", err)
+			}
+			if apiErr.StatusCode != tt.statusCode {
+				t.Errorf("Expected status code %d, got %d", tt.statusCode, apiErr.StatusCode)
+			}
+			if apiErr.URL != ts.URL+"/v1/league/123" {
+				t.Errorf("Expected URL %s, got %s", ts.URL+"/v1/league/123", apiErr.URL)
+			}
+			if apiErr.Body != `{"error": "details"}` {
+				t.Errorf("Expected body %s, got %s", `{"error": "details"}`, apiErr.Body)
+			}
+			if apiErr.RetryAfter != tt.wantRetry {
+				t.Errorf("Expected retry after %v, got %v", tt.wantRetry, apiErr.RetryAfter)
+			}
+			if apiErr.Error() != tt.wantString {
+				t.Errorf("Expected error string %q, got %q", tt.wantString, apiErr.Error())
+			}
+		})
+	}
+}
+
+func TestAPIErrorBodyTruncated(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(make([]byte, maxErrorBodySize*2))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+	})
+
+	_, err := client.GetRosters("123")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %v", err)
+	}
+	if len(apiErr.Body) != maxErrorBodySize {
+		t.Errorf("Expected body length %d, got %d", maxErrorBodySize, len(apiErr.Body))
+	}
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrRateLimited) || errors.Is(err, ErrServerError) {
+		t.Errorf("Expected 400 to match no sentinel error, got %v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{"", 0},
+		{"120", 2 * time.Minute},
+		{"-5", 0},
+		{"Sun, 01 Sep 2024 12:00:45 GMT", 45 * time.Second},
+		{"Sun, 01 Sep 2024 11:00:00 GMT", 0},
+		{"soon", 0},
+	}
+
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.expected {
+			t.Errorf("parseRetryAfter(%q) = %v, expected %v", tt.value, got, tt.expected)
+		}
+	}
+}
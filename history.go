@@ -0,0 +1,145 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// LeagueHistoryAPI is the set of methods FetchLeagueHistory needs, implemented by *Client.
+type LeagueHistoryAPI interface {
+	LeagueAPI
+	DraftAPI
+}
+
+// LeagueSeason holds a league and its users, rosters, and drafts for one season. Resources
+// that failed are left empty and their errors are joined in Err.
+type LeagueSeason struct {
+	League  League
+	Users   []LeagueUser
+	Rosters []Roster
+	Drafts  []Draft
+	Err     error
+}
+
+// LeagueHistory holds every season of a league found by following PreviousLeagueID,
+// starting with the newest season.
+type LeagueHistory struct {
+	Seasons []LeagueSeason
+
+	// The previous league ID that could not be followed and why. BrokenLink is empty
+	// when the history reached the league's first season.
+	BrokenLink string
+	BrokenErr  error
+}
+
+// Oldest returns the first season of the league that was found.
+func (h LeagueHistory) Oldest() (LeagueSeason, bool) {
+	if len(h.Seasons) == 0 {
+		return LeagueSeason{}, false
+	}
+	return h.Seasons[len(h.Seasons)-1], true
+}
+
+// Season returns the league for the season, for example "2023".
+func (h LeagueHistory) Season(season string) (LeagueSeason, bool) {
+	for _, s := range h.Seasons {
+		if s.League.Season == season {
+			return s, true
+		}
+	}
+	return LeagueSeason{}, false
+}
+
+// Get every season of a league by following PreviousLeagueID back to the league's first season.
+// Only an error getting the starting league or a cancelled context is returned. Errors for earlier leagues stop the
+// history and are recorded in BrokenLink and BrokenErr, and errors getting the users, rosters,
+// or drafts for a season are recorded in the season's Err.
+func (c *Client) GetLeagueHistory(league_id string) (LeagueHistory, error) {
+	return c.GetLeagueHistoryContext(context.Background(), league_id)
+}
+
+// GetLeagueHistoryContext is like GetLeagueHistory but accepts a context.
+func (c *Client) GetLeagueHistoryContext(ctx context.Context, league_id string) (LeagueHistory, error) {
+	return FetchLeagueHistory(ctx, c, league_id)
+}
+
+// FetchLeagueHistory follows PreviousLeagueID from the league back to its first season,
+// getting the users, rosters, and drafts for each season from the api.
+func FetchLeagueHistory(ctx context.Context, api LeagueHistoryAPI, league_id string) (LeagueHistory, error) {
+	history := LeagueHistory{}
+
+	c := clientOf(api)
+
+	seen := make(map[string]bool)
+	for id := league_id; !isFirstSeason(id); {
+		if seen[id] {
+			history.BrokenLink = id
+			history.BrokenErr = fmt.Errorf("league %s is already in the history", id)
+			break
+		}
+		seen[id] = true
+
+		league, err := api.GetLeagueContext(ctx, id)
+		if err == nil && league.LeagueID == "" {
+			// Sleeper returns null for leagues that no longer exist
+			err = fmt.Errorf("league %s: %w", id, ErrNotFound)
+		}
+		if err != nil {
+			// A cancelled context is not a broken link, so it is returned as the error
+			if len(history.Seasons) == 0 || ctx.Err() != nil {
+				return history, err
+			}
+
+			c.debug(ctx, "sleeper: league history link is broken",
+				slog.String("league_id", id),
+				slog.Any("error", err),
+			)
+			history.BrokenLink = id
+			history.BrokenErr = err
+			break
+		}
+
+		season := getLeagueSeason(ctx, api, league)
+		if err := ctx.Err(); err != nil {
+			return history, err
+		}
+		history.Seasons = append(history.Seasons, season)
+		id = league.PreviousLeagueID
+	}
+
+	return history, nil
+}
+
+// Get the users, rosters, and drafts for the league.
+func getLeagueSeason(ctx context.Context, api LeagueHistoryAPI, league League) LeagueSeason {
+	season := LeagueSeason{League: league}
+
+	var errs []error
+	users, err := api.GetLeagueUsersContext(ctx, league.LeagueID)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("users: %w", err))
+	}
+	season.Users = users
+
+	rosters, err := api.GetRostersContext(ctx, league.LeagueID)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("rosters: %w", err))
+	}
+	season.Rosters = rosters
+
+	drafts, err := api.GetDraftsForLeagueContext(ctx, league.LeagueID)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("drafts: %w", err))
+	}
+	season.Drafts = drafts
+
+	season.Err = errors.Join(errs...)
+	return season
+}
+
+// Check if there is no previous league. Sleeper uses either an empty value or "0".
+func isFirstSeason(league_id string) bool {
+	return league_id == "" || league_id == "0"
+}
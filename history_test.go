@@ -0,0 +1,149 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Server with leagues keyed by ID, linked to the previous league in the value.
+func newHistoryServer(leagues map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/league/"), "/")
+		id := parts[0]
+
+		previous, ok := leagues[id]
+		switch {
+		case !ok && len(parts) == 1:
+			w.Write([]byte(`null`))
+		case len(parts) == 1:
+			fmt.Fprintf(w, `{"league_id":%q,"previous_league_id":%q,"season":"202%s"}`, id, previous, id)
+		case parts[1] == "users":
+			fmt.Fprintf(w, `[{"user_id":"u%s"}]`, id)
+		case parts[1] == "rosters":
+			fmt.Fprintf(w, `[{"roster_id":1,"league_id":%q}]`, id)
+		case parts[1] == "drafts" && id == "2":
+			w.WriteHeader(http.StatusInternalServerError)
+		case parts[1] == "drafts":
+			fmt.Fprintf(w, `[{"draft_id":"d%s","league_id":%q}]`, id, id)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestGetLeagueHistory(t *testing.T) {
+	ts := newHistoryServer(map[string]string{"3": "2", "2": "1", "1": "0"})
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, RateLimit: 1000})
+
+	history, err := client.GetLeagueHistory("3")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(history.Seasons) != 3 {
+		t.Fatalf("Expected 3 seasons, got %d", len(history.Seasons))
+	}
+	if history.BrokenLink != "" {
+		t.Errorf("Expected no broken link, got %s (%v)", history.BrokenLink, history.BrokenErr)
+	}
+
+	for i, id := range []string{"3", "2", "1"} {
+		season := history.Seasons[i]
+		if season.League.LeagueID != id {
+			t.Errorf("Expected season %d to be league %s, got %s", i, id, season.League.LeagueID)
+		}
+		if len(season.Users) != 1 || season.Users[0].UserID != "u"+id {
+			t.Errorf("Expected user u%s, got %v", id, season.Users)
+		}
+		if len(season.Rosters) != 1 {
+			t.Errorf("Expected 1 roster for league %s, got %d", id, len(season.Rosters))
+		}
+	}
+
+	if !errors.Is(history.Seasons[1].Err, ErrServerError) {
+		t.Errorf("Expected drafts error for league 2, got %v", history.Seasons[1].Err)
+	}
+	if len(history.Seasons[0].Drafts) != 1 || history.Seasons[0].Drafts[0].DraftID != "d3" {
+		t.Errorf("Expected draft d3, got %v", history.Seasons[0].Drafts)
+	}
+
+	oldest, ok := history.Oldest()
+	if !ok || oldest.League.LeagueID != "1" {
+		t.Errorf("Expected oldest league 1, got %s", oldest.League.LeagueID)
+	}
+
+	season, ok := history.Season("2022")
+	if !ok || season.League.LeagueID != "2" {
+		t.Errorf("Expected 2022 to be league 2, got %s", season.League.LeagueID)
+	}
+}
+
+func TestGetLeagueHistoryBrokenLink(t *testing.T) {
+	ts := newHistoryServer(map[string]string{"3": "2", "2": "missing", "5": "6", "6": "5"})
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, RateLimit: 1000})
+
+	history, err := client.GetLeagueHistory("3")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(history.Seasons) != 2 {
+		t.Errorf("Expected 2 seasons, got %d", len(history.Seasons))
+	}
+	if history.BrokenLink != "missing" || !errors.Is(history.BrokenErr, ErrNotFound) {
+		t.Errorf("Expected missing league to be not found, got %s (%v)", history.BrokenLink, history.BrokenErr)
+	}
+
+	// Leagues that link to each other stop instead of looping forever
+	history, err = client.GetLeagueHistory("5")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(history.Seasons) != 2 || history.BrokenLink != "5" {
+		t.Errorf("Expected 2 seasons and a broken link to 5, got %d and %s", len(history.Seasons), history.BrokenLink)
+	}
+
+	_, err = client.GetLeagueHistory("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for the starting league, got %v", err)
+	}
+}
+
+func TestGetLeagueHistoryCancelled(t *testing.T) {
+	history := newHistoryServer(map[string]string{"3": "2", "2": "1", "1": "0"})
+	defer history.Close()
+
+	// Cancel the context once the second season is requested
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/league/2" {
+			cancel()
+		}
+		http.Redirect(w, r, history.URL+r.URL.Path, http.StatusTemporaryRedirect)
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, RateLimit: 1000})
+
+	h, err := client.GetLeagueHistoryContext(ctx, "3")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if h.BrokenLink != "" || h.BrokenErr != nil {
+		t.Errorf("Expected no broken link, got %s (%v)", h.BrokenLink, h.BrokenErr)
+	}
+	for _, season := range h.Seasons {
+		if season.Err != nil {
+			t.Errorf("Expected complete seasons only, got error %v for league %s", season.Err, season.League.LeagueID)
+		}
+	}
+}
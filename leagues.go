@@ -1,6 +1,7 @@
 package sleeper
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -84,6 +85,7 @@ type League struct {
 		DailyWaivers             int `json:"daily_waivers"`
 		DailyWaiversHour         int `json:"daily_waivers_hour"`
 		DisableAdds              int `json:"disable_adds"`
+		Divisions                int `json:"divisions"`
 		DraftRounds              int `json:"draft_rounds"`
 		LeagueAverageMatch       int `json:"league_average_match"`
 		Leg                      int `json:"leg"`
@@ -117,9 +119,12 @@ type League struct {
 		WaiverType               int `json:"waiver_type"`
 	} `json:"settings"`
 	Shard        int    `json:"shard"`
-	Sport        string `json:"sport"`
+	Sport        Sport  `json:"sport"`
 	Status       string `json:"status"`
 	TotalRosters int    `json:"total_rosters"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type Roster struct {
@@ -138,6 +143,7 @@ type Roster struct {
 	Reserve   interface{} `json:"reserve"`
 	RosterID  int         `json:"roster_id"`
 	Settings  struct {
+		Division         int `json:"division"`
 		Fpts             int `json:"fpts"`
 		Losses           int `json:"losses"`
 		Ties             int `json:"ties"`
@@ -148,6 +154,9 @@ type Roster struct {
 	} `json:"settings"`
 	Starters []string    `json:"starters"`
 	Taxi     interface{} `json:"taxi"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type LeagueUser struct {
@@ -175,6 +184,9 @@ type LeagueUser struct {
 	} `json:"metadata,omitempty"`
 	Settings interface{} `json:"settings"`
 	UserID   string      `json:"user_id"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type Matchup struct {
@@ -186,6 +198,9 @@ type Matchup struct {
 	RosterID       int                `json:"roster_id"`
 	Starters       []string           `json:"starters"`
 	StartersPoints []float32          `json:"starters_points"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type PlayoffRound struct {
@@ -204,6 +219,9 @@ type PlayoffRound struct {
 		W int `json:"w,omitempty"`
 	} `json:"t2_from,omitempty"`
 	W int `json:"w"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type Transaction struct {
@@ -232,6 +250,9 @@ type Transaction struct {
 		Receiver int `json:"receiver"`
 		Sender   int `json:"sender"`
 	} `json:"waiver_budget"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type SportState struct {
@@ -244,11 +265,19 @@ type SportState struct {
 	SeasonStartDate    string `json:"season_start_date"`
 	SeasonType         string `json:"season_type"`
 	Week               int    `json:"week"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 // Get all leagues for a specific user, sport, and season.
 // (GET `https://api.sleeper.app/v1/user/<user_id>/leagues/<sport>/<season>`)
-func (c *Client) GetAllLeagesForUser(user_id string, sport string, season int) ([]League, error) {
+func (c *Client) GetAllLeagesForUser(user_id string, sport Sport, season int) ([]League, error) {
+	return c.GetAllLeagesForUserContext(context.Background(), user_id, sport, season)
+}
+
+// GetAllLeagesForUserContext is like GetAllLeagesForUser but accepts a context.
+func (c *Client) GetAllLeagesForUserContext(ctx context.Context, user_id string, sport Sport, season int) ([]League, error) {
 	leagues := []League{}
 
 	// Sleeper only has data from 2009 to present
@@ -256,14 +285,18 @@ func (c *Client) GetAllLeagesForUser(user_id string, sport string, season int) (
 		return leagues, errors.New("invalid year - must be between 2008 and current")
 	}
 
+	if err := sport.Validate(); err != nil {
+		return leagues, err
+	}
+
 	url := fmt.Sprintf("%s/v1/user/%s/leagues/%s/%d", c.sleeperURL, user_id, sport, season)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return leagues, err
 	}
 
-	err = json.Unmarshal(data, &leagues)
+	err = c.decodeJSON(ctx, url, data, &leagues)
 
 	return leagues, err
 }
@@ -271,16 +304,21 @@ func (c *Client) GetAllLeagesForUser(user_id string, sport string, season int) (
 // Get a specific league by the league_id.
 // (GET `https://api.sleeper.app/v1/league/<league_id>`)
 func (c *Client) GetLeague(league_id string) (League, error) {
+	return c.GetLeagueContext(context.Background(), league_id)
+}
+
+// GetLeagueContext is like GetLeague but accepts a context.
+func (c *Client) GetLeagueContext(ctx context.Context, league_id string) (League, error) {
 	league := League{}
 
 	url := fmt.Sprintf("%s/v1/league/%s", c.sleeperURL, league_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return league, err
 	}
 
-	err = json.Unmarshal(data, &league)
+	err = c.decodeJSON(ctx, url, data, &league)
 
 	return league, err
 }
@@ -288,32 +326,42 @@ func (c *Client) GetLeague(league_id string) (League, error) {
 // Get all rosters in a league.
 // (GET `https://api.sleeper.app/v1/league/<league_id>/rosters`)
 func (c *Client) GetRosters(league_id string) ([]Roster, error) {
+	return c.GetRostersContext(context.Background(), league_id)
+}
+
+// GetRostersContext is like GetRosters but accepts a context.
+func (c *Client) GetRostersContext(ctx context.Context, league_id string) ([]Roster, error) {
 	rosters := []Roster{}
 
 	url := fmt.Sprintf("%s/v1/league/%s/rosters", c.sleeperURL, league_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return rosters, err
 	}
 
-	err = json.Unmarshal(data, &rosters)
+	err = c.decodeJSON(ctx, url, data, &rosters)
 	return rosters, err
 }
 
 // Get all users in a league.
 // (GET `https://api.sleeper.app/v1/league/<league_id>/users`)
 func (c *Client) GetLeagueUsers(league_id string) ([]LeagueUser, error) {
+	return c.GetLeagueUsersContext(context.Background(), league_id)
+}
+
+// GetLeagueUsersContext is like GetLeagueUsers but accepts a context.
+func (c *Client) GetLeagueUsersContext(ctx context.Context, league_id string) ([]LeagueUser, error) {
 	leagueUsers := []LeagueUser{}
 
 	url := fmt.Sprintf("%s/v1/league/%s/users", c.sleeperURL, league_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return leagueUsers, err
 	}
 
-	err = json.Unmarshal(data, &leagueUsers)
+	err = c.decodeJSON(ctx, url, data, &leagueUsers)
 
 	return leagueUsers, err
 }
@@ -321,96 +369,130 @@ func (c *Client) GetLeagueUsers(league_id string) ([]LeagueUser, error) {
 // Get all matchups in a league for a given week. Each object in the list represents one team. The two teams with the same matchup_id match up against each other.
 // (GET `https://api.sleeper.app/v1/league/<league_id>/matchups/<week>`)
 func (c *Client) GetMatchups(league_id string, week int) ([]Matchup, error) {
+	return c.GetMatchupsContext(context.Background(), league_id, week)
+}
+
+// GetMatchupsContext is like GetMatchups but accepts a context.
+func (c *Client) GetMatchupsContext(ctx context.Context, league_id string, week int) ([]Matchup, error) {
 	matchups := []Matchup{}
 
 	url := fmt.Sprintf("%s/v1/league/%s/matchups/%d", c.sleeperURL, league_id, week)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return matchups, err
 	}
 
-	err = json.Unmarshal(data, &matchups)
+	err = c.decodeJSON(ctx, url, data, &matchups)
 	return matchups, err
 }
 
 // Get the playoff winners bracket for a league for 4, 6, and 8 team playoffs.
 // (GET `https://api.sleeper.app/v1/league/<league_id>/winners_bracket`)
 func (c *Client) GetPlayoffsWinnersBracket(league_id string) ([]PlayoffRound, error) {
+	return c.GetPlayoffsWinnersBracketContext(context.Background(), league_id)
+}
+
+// GetPlayoffsWinnersBracketContext is like GetPlayoffsWinnersBracket but accepts a context.
+func (c *Client) GetPlayoffsWinnersBracketContext(ctx context.Context, league_id string) ([]PlayoffRound, error) {
 	playoffRounds := []PlayoffRound{}
 
 	url := fmt.Sprintf("%s/v1/league/%s/winners_bracket", c.sleeperURL, league_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return playoffRounds, err
 	}
 
-	err = json.Unmarshal(data, &playoffRounds)
+	err = c.decodeJSON(ctx, url, data, &playoffRounds)
 	return playoffRounds, err
 }
 
 // Get the playoff losers bracket for a league for 4, 6, and 8 team playoffs.
 // (GET `https://api.sleeper.app/v1/league/<league_id>/losers_bracket`)
 func (c *Client) GetPlayoffsLosersBracket(league_id string) ([]PlayoffRound, error) {
+	return c.GetPlayoffsLosersBracketContext(context.Background(), league_id)
+}
+
+// GetPlayoffsLosersBracketContext is like GetPlayoffsLosersBracket but accepts a context.
+func (c *Client) GetPlayoffsLosersBracketContext(ctx context.Context, league_id string) ([]PlayoffRound, error) {
 	playoffRounds := []PlayoffRound{}
 
 	url := fmt.Sprintf("%s/v1/league/%s/losers_bracket", c.sleeperURL, league_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return playoffRounds, err
 	}
 
-	err = json.Unmarshal(data, &playoffRounds)
+	err = c.decodeJSON(ctx, url, data, &playoffRounds)
 	return playoffRounds, err
 }
 
 // Get all free agent transactions, waivers and trades.
 // (GET `https://api.sleeper.app/v1/league/<league_id>/transactions/<round>`)
 func (c *Client) GetTransactions(league_id string, round int) ([]Transaction, error) {
+	return c.GetTransactionsContext(context.Background(), league_id, round)
+}
+
+// GetTransactionsContext is like GetTransactions but accepts a context.
+func (c *Client) GetTransactionsContext(ctx context.Context, league_id string, round int) ([]Transaction, error) {
 	transactions := []Transaction{}
 
 	url := fmt.Sprintf("%s/v1/league/%s/transactions/%d", c.sleeperURL, league_id, round)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return transactions, err
 	}
 
-	err = json.Unmarshal(data, &transactions)
+	err = c.decodeJSON(ctx, url, data, &transactions)
 	return transactions, err
 }
 
 // Get all traded picks in a league, including future picks.
 // (GET `https://api.sleeper.app/v1/league/<league_id>/traded_picks`)
 func (c *Client) GetLeagueTradedPicks(league_id string) ([]TradedPick, error) {
+	return c.GetLeagueTradedPicksContext(context.Background(), league_id)
+}
+
+// GetLeagueTradedPicksContext is like GetLeagueTradedPicks but accepts a context.
+func (c *Client) GetLeagueTradedPicksContext(ctx context.Context, league_id string) ([]TradedPick, error) {
 	tradedPicks := []TradedPick{}
 
 	url := fmt.Sprintf("%s/v1/league/%s/traded_picks", c.sleeperURL, league_id)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return tradedPicks, err
 	}
 
-	err = json.Unmarshal(data, &tradedPicks)
+	err = c.decodeJSON(ctx, url, data, &tradedPicks)
 
 	return tradedPicks, err
 }
 
 // Get information about the current state for any sport.
 // (GET `https://api.sleeper.app/v1/state/<sport>`)
-func (c *Client) GetSportState(sport string) (SportState, error) {
+func (c *Client) GetSportState(sport Sport) (SportState, error) {
+	return c.GetSportStateContext(context.Background(), sport)
+}
+
+// GetSportStateContext is like GetSportState but accepts a context.
+func (c *Client) GetSportStateContext(ctx context.Context, sport Sport) (SportState, error) {
 	sportstate := SportState{}
 
+	if err := sport.Validate(); err != nil {
+		return sportstate, err
+	}
+
 	url := fmt.Sprintf("%s/v1/state/%s", c.sleeperURL, sport)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return sportstate, err
 	}
 
-	err = json.Unmarshal(data, &sportstate)
+	err = c.decodeJSON(ctx, url, data, &sportstate)
 	return sportstate, err
 }
@@ -73,6 +73,7 @@ func TestGetLeague(t *testing.T) {
 			DailyWaivers             int `json:"daily_waivers"`
 			DailyWaiversHour         int `json:"daily_waivers_hour"`
 			DisableAdds              int `json:"disable_adds"`
+			Divisions                int `json:"divisions"`
 			DraftRounds              int `json:"draft_rounds"`
 			LeagueAverageMatch       int `json:"league_average_match"`
 			Leg                      int `json:"leg"`
@@ -152,6 +153,7 @@ func TestGetRosters(t *testing.T) {
 			OwnerID:  "123",
 			Players:  []string{"player1", "player2"},
 			Settings: struct {
+				Division         int `json:"division"`
 				Fpts             int `json:"fpts"`
 				Losses           int `json:"losses"`
 				Ties             int `json:"ties"`
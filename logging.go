@@ -0,0 +1,63 @@
+package sleeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+)
+
+// Value logged in place of sensitive fields when redaction is enabled.
+const redacted string = "[REDACTED]"
+
+// Log a debug record if a logger is configured. Safe to call on a nil client.
+func (c *Client) debug(ctx context.Context, msg string, args ...any) {
+	if c == nil || c.logger == nil {
+		return
+	}
+	c.logger.DebugContext(ctx, msg, args...)
+}
+
+// Get the attribute for the request URL. The URL contains user names and IDs,
+// so the endpoint template is logged instead when redaction is enabled.
+func (c *Client) urlAttr(url string) slog.Attr {
+	if c.redactLogs {
+		return slog.String("url", c.endpoint(url))
+	}
+	return slog.String("url", url)
+}
+
+// Get an attribute for a sensitive value such as a user or team name. Safe to call on a nil client.
+func (c *Client) sensitiveAttr(key string, value string) slog.Attr {
+	if c != nil && c.redactLogs {
+		return slog.String(key, redacted)
+	}
+	return slog.String(key, value)
+}
+
+// Decode the JSON response, logging any failure.
+func (c *Client) decodeJSON(ctx context.Context, url string, data []byte, v any) error {
+	// Report differences from the model instead of failing in strict mode
+	decoded := data
+	if c.drift != nil {
+		decoded = c.checkDrift(ctx, url, data, v)
+	}
+
+	err := json.Unmarshal(decoded, v)
+	if err != nil {
+		c.debug(ctx, "sleeper: failed to decode response",
+			c.urlAttr(url),
+			slog.String("endpoint", c.endpoint(url)),
+			slog.Int("bytes", len(data)),
+			slog.Any("error", err),
+		)
+		return err
+	}
+
+	// Models decoded from the copy without mismatched fields keep the response as it was sent
+	if !bytes.Equal(decoded, data) {
+		restoreRaw(data, reflect.ValueOf(v))
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package sleeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Decode the JSON log records written to the buffer.
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		record := map[string]any{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Failed to decode log record %s: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func newFantasyInfoServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/v1/league/123":
+			w.Write([]byte(`{"league_id": "123", "sport": "nfl"}`))
+		case "/v1/league/123/matchups/1":
+			w.Write([]byte(`[{"roster_id": 1, "matchup_id": 1, "points": 100}]`))
+		case "/v1/league/123/rosters":
+			w.Write([]byte(`[{"roster_id": 1, "owner_id": "u1"}]`))
+		case "/v1/league/123/users":
+			w.Write([]byte(`[{"user_id": "u1", "display_name": "secret_name", "metadata": {}}]`))
+		case "/v1/state/nfl":
+			w.Write([]byte(`{"week": "not a number"}`))
+		}
+	}))
+}
+
+func TestLogging(t *testing.T) {
+	ts := newFantasyInfoServer()
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Logger:  slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	})
+
+	if _, err := client.GetScoreboards("123", 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.GetSportState("nfl"); err == nil {
+		t.Fatal("Expected decode error")
+	}
+
+	messages := map[string]map[string]any{}
+	for _, record := range logRecords(t, &buf) {
+		if record["level"] != "DEBUG" {
+			t.Errorf("Expected debug level, got %v", record["level"])
+		}
+		messages[record["msg"].(string)] = record
+	}
+
+	for _, msg := range []string{
+		"sleeper: request",
+		"sleeper: rate limiter wait",
+		"sleeper: user has no team name, using display name",
+		"sleeper: correlated team",
+		"sleeper: failed to decode response",
+	} {
+		if _, ok := messages[msg]; !ok {
+			t.Errorf("Expected log record %q", msg)
+		}
+	}
+
+	team := messages["sleeper: correlated team"]
+	if team["team_name"] != "Team secret_name" || team["found_roster"] != true || team["found_matchup"] != true {
+		t.Errorf("Unexpected correlated team record %v", team)
+	}
+	if decode := messages["sleeper: failed to decode response"]; decode["endpoint"] != EndpointSportState {
+		t.Errorf("Unexpected decode failure record %v", decode)
+	}
+}
+
+func TestLoggingRedacted(t *testing.T) {
+	ts := newFantasyInfoServer()
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL:    ts.URL,
+		Logger:     slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		RedactLogs: true,
+	})
+
+	if _, err := client.GetScoreboards("123", 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	output := buf.String()
+	for _, secret := range []string{"secret_name", "u1", ts.URL} {
+		if strings.Contains(output, secret) {
+			t.Errorf("Expected %q to be redacted from logs\n%s", secret, output)
+		}
+	}
+	if !strings.Contains(output, EndpointMatchups) {
+		t.Errorf("Expected endpoint templates in redacted logs\n%s", output)
+	}
+}
+
+func TestLoggingDisabled(t *testing.T) {
+	ts := newFantasyInfoServer()
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+	})
+
+	// Logging without a logger must not panic
+	if _, err := client.GetScoreboards("123", 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package sleeper
+
+import (
+	"slices"
+)
+
+// MatchResult is the result of a game for one team.
+type MatchResult string
+
+const (
+	ResultWin  MatchResult = "W"
+	ResultLoss MatchResult = "L"
+	ResultTie  MatchResult = "T"
+)
+
+// Get the result of scoring points against an opponent or the league median.
+func matchResult(points, against float32) MatchResult {
+	switch {
+	case points > against:
+		return ResultWin
+	case points < against:
+		return ResultLoss
+	default:
+		return ResultTie
+	}
+}
+
+// MedianScore returns the median of the points, or the average of the two middle
+// scores when there is an even number of them.
+func MedianScore(points []float32) float32 {
+	if len(points) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(points)
+	slices.Sort(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
+
+// Get the league median for the week and the result of each roster against it. Leagues
+// with league_average_match play every team against the median each week as an extra game.
+// Only teams in a scored game against an opponent count, the same games the standings
+// count, so byes and games that have not started are left out. The result is false when
+// no game has been scored yet.
+func medianResults(matchups []Matchup) (float32, map[int]MatchResult, bool) {
+	var points []float32
+	var played []Matchup
+	for _, game := range pairMatchups(matchups) {
+		for _, m := range game {
+			points = append(points, matchupPoints(m))
+			played = append(played, m)
+		}
+	}
+	if len(played) == 0 {
+		return 0, nil, false
+	}
+
+	median := MedianScore(points)
+	results := make(map[int]MatchResult, len(played))
+	for _, m := range played {
+		results[m.RosterID] = matchResult(matchupPoints(m), median)
+	}
+
+	return median, results, true
+}
@@ -0,0 +1,123 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestMedianScore(t *testing.T) {
+	tests := []struct {
+		points []float32
+		want   float32
+	}{
+		{nil, 0},
+		{[]float32{90}, 90},
+		{[]float32{120, 80, 100}, 100},
+		{[]float32{120, 80, 100, 90}, 95},
+	}
+
+	for _, tt := range tests {
+		if got := MedianScore(tt.points); got != tt.want {
+			t.Errorf("Expected median of %v to be %v, got %v", tt.points, tt.want, got)
+		}
+	}
+}
+
+func TestTeamMatchupsMedian(t *testing.T) {
+	fake := newFakeLeagueAPI()
+	fake.league.Settings.LeagueAverageMatch = 1
+
+	// A league that is passed in is not fetched again
+	matchups, err := FetchTeamMatchupsForLeague(context.Background(), noLeagueAPI{fake}, fake.league, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := []TeamMatchup{{Teamname1: "Team A", Teamname2: "Team bob", Team1Wins: 2, Team2Losses: 2, Team1Median: ResultWin, Team2Median: ResultLoss}}
+	if !slices.Equal(matchups, want) {
+		t.Errorf("Expected %+v, got %+v", want, matchups)
+	}
+
+	scoreboards, err := FetchScoreboardsForLeague(context.Background(), noLeagueAPI{fake}, fake.league, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	wantScoreboards := []Scoreboard{{Teamname1: "Team A", Teamname2: "Team bob", Points1: 120.5, Points2: 98, Median: 109.25, MedianResult1: ResultWin, MedianResult2: ResultLoss}}
+	if !slices.Equal(scoreboards, wantScoreboards) {
+		t.Errorf("Expected %+v, got %+v", wantScoreboards, scoreboards)
+	}
+
+	// The league is fetched for the current week and for a specific week
+	for _, week := range []int{0, 3} {
+		scoreboards, err = FetchScoreboards(context.Background(), fake, "1", week)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !slices.Equal(scoreboards, wantScoreboards) {
+			t.Errorf("Expected %+v for week %d, got %+v", wantScoreboards, week, scoreboards)
+		}
+
+		matchups, err = FetchTeamMatchups(context.Background(), fake, "1", week)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !slices.Equal(matchups, want) {
+			t.Errorf("Expected %+v for week %d, got %+v", want, week, matchups)
+		}
+	}
+}
+
+// Fake league API that fails when the league is fetched.
+type noLeagueAPI struct {
+	*fakeLeagueAPI
+}
+
+func (f noLeagueAPI) GetLeagueContext(ctx context.Context, league_id string) (League, error) {
+	return League{}, errors.New("league should not be fetched")
+}
+
+func TestMedianResultsSkipsUnpairedTeams(t *testing.T) {
+	matchups := []Matchup{
+		{RosterID: 1, MatchupID: 1, Points: 120},
+		{RosterID: 2, MatchupID: 1, Points: 100},
+		{RosterID: 3, MatchupID: 2, Points: 90},
+		{RosterID: 4, MatchupID: 2, Points: 80},
+		{RosterID: 5, Points: 200}, // No opponent
+		{RosterID: 6, MatchupID: 3},
+		{RosterID: 7, MatchupID: 3}, // Not started
+	}
+
+	median, results, ok := medianResults(matchups)
+	if !ok || median != 95 {
+		t.Fatalf("Expected median 95, got %v (%v)", median, ok)
+	}
+	if len(results) != 4 || results[1] != ResultWin || results[4] != ResultLoss {
+		t.Errorf("Expected results for the 4 paired teams, got %v", results)
+	}
+	if _, ok := results[5]; ok {
+		t.Errorf("Expected no result for the unpaired team, got %v", results[5])
+	}
+}
+
+func TestBuildStandingsMedian(t *testing.T) {
+	f := newStandingsFake()
+	f.league.Settings.LeagueAverageMatch = 1
+
+	standings := BuildStandings(f.league, f.rosters, f.users, f.matchups, StandingsOptions{})
+
+	// Roster 2 beats the median every week and passes roster 1
+	if order := standingsOrder(standings); !slices.Equal(order, []int{2, 1, 3, 4}) {
+		t.Errorf("Expected order [2 1 3 4], got %v", order)
+	}
+
+	first := standings.Teams[0]
+	if first.Wins != 2 || first.Losses != 1 || first.MedianWins != 3 || first.MedianLosses != 0 {
+		t.Errorf("Expected roster 2 to be 2-1 and 3-0 against the median, got %+v", first)
+	}
+	if first.Games() != 6 {
+		t.Errorf("Expected 6 games, got %d", first.Games())
+	}
+}
@@ -0,0 +1,37 @@
+package sleeper
+
+import "time"
+
+// RequestEvent describes a completed request, including any retries.
+type RequestEvent struct {
+	Endpoint    string        // Endpoint template such as /v1/league/:league_id/matchups/:week
+	URL         string        // URL that was requested
+	StatusCode  int           // Status code of the last response, zero if no response was received
+	Bytes       int           // Size of the response body returned to the caller
+	Attempts    int           // Number of requests sent, zero when served from the cache or shared
+	Cached      bool          // Whether the response was served from the cache
+	Shared      bool          // Whether the response came from a concurrent request for the same URL
+	Duration    time.Duration // Total time spent, including rate limiter waits and retries
+	LimiterWait time.Duration // Time spent waiting for the rate limiter across all attempts
+	Err         error         // Error returned to the caller
+}
+
+// Retries returns the number of requests sent after the first one.
+func (e RequestEvent) Retries() int {
+	return max(e.Attempts-1, 0)
+}
+
+// Observer receives an event after every request made by the client.
+//
+// ObserveRequest is called synchronously and may be called from multiple goroutines.
+type Observer interface {
+	ObserveRequest(event RequestEvent)
+}
+
+// ObserverFunc adapts a function to the Observer interface.
+type ObserverFunc func(event RequestEvent)
+
+// ObserveRequest calls f(event).
+func (f ObserverFunc) ObserveRequest(event RequestEvent) {
+	f(event)
+}
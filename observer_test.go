@@ -0,0 +1,100 @@
+package sleeper
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestObserver(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/league/123/matchups/5" && hits.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == "/v1/league/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	var mu sync.Mutex
+	var events []RequestEvent
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Cache:   NewMemoryCache(10),
+		Retry:   RetryPolicy{MaxAttempts: 2, BaseBackoff: time.Millisecond},
+		Observer: ObserverFunc(func(event RequestEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		}),
+	})
+
+	client.GetMatchups("123", 5)
+	client.GetMatchups("123", 5)
+	client.GetLeague("missing")
+
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+
+	first := events[0]
+	if first.Endpoint != EndpointMatchups {
+		t.Errorf("Expected endpoint %s, got %s", EndpointMatchups, first.Endpoint)
+	}
+	if first.StatusCode != http.StatusOK || first.Attempts != 2 || first.Retries() != 1 {
+		t.Errorf("Expected status 200 after 2 attempts, got %d after %d", first.StatusCode, first.Attempts)
+	}
+	if first.Bytes != 2 || first.Cached || first.Err != nil {
+		t.Errorf("Unexpected event %+v", first)
+	}
+	if first.Duration < first.LimiterWait {
+		t.Errorf("Expected duration %v to include limiter wait %v", first.Duration, first.LimiterWait)
+	}
+
+	cached := events[1]
+	if !cached.Cached || cached.Attempts != 0 || cached.LimiterWait != 0 {
+		t.Errorf("Expected cached event without attempts, got %+v", cached)
+	}
+
+	failed := events[2]
+	if failed.Endpoint != EndpointLeague || failed.StatusCode != http.StatusNotFound || !errors.Is(failed.Err, ErrNotFound) {
+		t.Errorf("Expected not found event, got %+v", failed)
+	}
+}
+
+func TestObserverLimiterWait(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	var waits []time.Duration
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL:   ts.URL,
+		RateLimit: 10,
+		Observer: ObserverFunc(func(event RequestEvent) {
+			waits = append(waits, event.LimiterWait)
+		}),
+	})
+
+	client.GetSportState("nfl")
+	client.GetSportState("nfl")
+
+	if len(waits) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(waits))
+	}
+	if waits[1] < 50*time.Millisecond {
+		t.Errorf("Expected second request to wait for the limiter, waited %v", waits[1])
+	}
+}
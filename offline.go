@@ -0,0 +1,117 @@
+package sleeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"time"
+)
+
+// ErrOfflineMiss is returned in offline mode when the response was not saved by an earlier run.
+var ErrOfflineMiss = errors.New("sleeper: offline and response not saved")
+
+// StaleCache is a Cache that can return responses after they expire. In offline mode
+// the client reads expired responses so data saved by earlier runs is still served.
+type StaleCache interface {
+	Cache
+	// Get the cached response for the key even if it has expired.
+	GetStale(key string) ([]byte, bool)
+}
+
+// Offline reports whether the client serves every request from its local store without using the network.
+func (c *Client) Offline() bool {
+	return c.offline
+}
+
+// Get the saved response for the URL in offline mode.
+func (c *Client) getOffline(url string, event *RequestEvent) ([]byte, error) {
+	data, ok := c.getSaved(url)
+	if !ok {
+		// Players can also be read from a snapshot saved by a PlayerSnapshotStore
+		file := c.snapshotFile(url)
+		if file == "" {
+			return nil, c.offlineMiss(url)
+		}
+
+		var err error
+		if data, err = os.ReadFile(file); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrOfflineMiss, url, err)
+		}
+	}
+
+	event.Cached = true
+	event.StatusCode = http.StatusOK
+	return data, nil
+}
+
+// Get the saved response for the URL in offline mode as a stream. Player snapshots
+// are streamed from disk instead of being read into memory.
+func (c *Client) getOfflineStream(ctx context.Context, url string) (io.ReadCloser, error) {
+	event := RequestEvent{URL: url}
+	start := time.Now()
+
+	if data, ok := c.getSaved(url); ok {
+		event.Cached = true
+		event.StatusCode = http.StatusOK
+		c.finishRequest(ctx, &event, start, len(data), nil)
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	file := c.snapshotFile(url)
+	if file == "" {
+		err := c.offlineMiss(url)
+		c.finishRequest(ctx, &event, start, 0, err)
+		return nil, err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrOfflineMiss, url, err)
+		c.finishRequest(ctx, &event, start, 0, err)
+		return nil, err
+	}
+
+	event.Cached = true
+	event.StatusCode = http.StatusOK
+	return &streamBody{
+		ReadCloser: f,
+		finish: func(bytes int, err error) {
+			c.finishRequest(ctx, &event, start, bytes, err)
+		},
+	}, nil
+}
+
+// Get the cached response for the URL, including expired responses when the cache keeps them.
+func (c *Client) getSaved(url string) ([]byte, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+
+	get := c.cache.Get
+	if stale, ok := c.cache.(StaleCache); ok {
+		get = stale.GetStale
+	}
+	return get(url)
+}
+
+// Get the player snapshot file for a players URL, empty for other URLs or when no
+// snapshot directory is set.
+func (c *Client) snapshotFile(url string) string {
+	if c.snapshotDir == "" || c.endpoint(url) != EndpointPlayers {
+		return ""
+	}
+	return playerSnapshotPath(c.snapshotDir, Sport(path.Base(url)))
+}
+
+// Get the error for a response that was not saved.
+func (c *Client) offlineMiss(url string) error {
+	if c.cache == nil && c.snapshotDir == "" {
+		return fmt.Errorf("%w: no cache or player snapshot directory is configured: %s", ErrOfflineMiss, url)
+	}
+	return fmt.Errorf("%w: %s", ErrOfflineMiss, url)
+}
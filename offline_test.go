@@ -0,0 +1,178 @@
+package sleeper
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOfflineClient(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"league_id": "123"}`))
+	}))
+	defer ts.Close()
+
+	// Save an expired response as if an earlier run had fetched it
+	cache := NewMemoryCache(0)
+	cache.Set(ts.URL+"/v1/league/123", []byte(`{"league_id": "123"}`), -time.Second)
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Cache:   cache,
+		Offline: true,
+	})
+
+	if !client.Offline() {
+		t.Error("Expected client to be offline")
+	}
+
+	league, err := client.GetLeague("123")
+	if err != nil {
+		t.Fatalf("Expected saved league, got %v", err)
+	}
+	if league.LeagueID != "123" {
+		t.Errorf("Expected league 123, got %s", league.LeagueID)
+	}
+
+	_, err = client.GetLeague("456")
+	if !errors.Is(err, ErrOfflineMiss) {
+		t.Errorf("Expected ErrOfflineMiss, got %v", err)
+	}
+
+	_, err = client.GetAllPlayers(SportNFL)
+	if !errors.Is(err, ErrOfflineMiss) {
+		t.Errorf("Expected ErrOfflineMiss for players, got %v", err)
+	}
+
+	if hits.Load() != 0 {
+		t.Errorf("Expected 0 requests, got %d", hits.Load())
+	}
+}
+
+func TestOfflineClientWithoutCache(t *testing.T) {
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: "http://127.0.0.1:0",
+		Offline: true,
+	})
+
+	_, err := client.GetLeague("123")
+	if !errors.Is(err, ErrOfflineMiss) {
+		t.Errorf("Expected ErrOfflineMiss, got %v", err)
+	}
+
+	err = client.StreamAllPlayers(SportNFL, func(id string, player Player) error { return nil })
+	if !errors.Is(err, ErrOfflineMiss) {
+		t.Errorf("Expected ErrOfflineMiss for stream, got %v", err)
+	}
+}
+
+func TestOfflineClientReadsPlayerSnapshot(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"1": {"player_id": "1"}, "2": {"player_id": "2"}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Cache:   NewMemoryCache(0),
+	})
+
+	dir := t.TempDir()
+	store, err := NewPlayerSnapshotStore(&client, dir)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	if _, err := store.Refresh(SportNFL); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	offline := NewClientWithOptions(ClientOptions{
+		BaseURL:           ts.URL,
+		Offline:           true,
+		PlayerSnapshotDir: dir,
+	})
+
+	players, err := offline.GetAllPlayers(SportNFL)
+	if err != nil {
+		t.Fatalf("Expected players from the snapshot, got %v", err)
+	}
+	if len(players) != 2 {
+		t.Errorf("Expected 2 players, got %d", len(players))
+	}
+
+	count := 0
+	err = offline.StreamAllPlayers(SportNFL, func(id string, player Player) error {
+		count++
+		return nil
+	})
+	if err != nil || count != 2 {
+		t.Errorf("Expected 2 streamed players, got %d and %v", count, err)
+	}
+
+	// Other sports and endpoints are still misses
+	if _, err := offline.GetAllPlayers(SportNBA); !errors.Is(err, ErrOfflineMiss) {
+		t.Errorf("Expected ErrOfflineMiss for a sport without a snapshot, got %v", err)
+	}
+	if _, err := offline.GetLeague("123"); !errors.Is(err, ErrOfflineMiss) {
+		t.Errorf("Expected ErrOfflineMiss for a league, got %v", err)
+	}
+
+	if hits.Load() != 1 {
+		t.Errorf("Expected 1 request, got %d", hits.Load())
+	}
+}
+
+func TestOfflinePlayerSnapshot(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"1": {"player_id": "1"}}`))
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+
+	online := NewClientWithOptions(ClientOptions{BaseURL: ts.URL})
+	store, err := NewPlayerSnapshotStore(&online, dir)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	if _, err := store.Refresh(SportNFL); err != nil {
+		t.Fatalf("Failed to refresh: %v", err)
+	}
+
+	offline := NewClientWithOptions(ClientOptions{BaseURL: ts.URL, Offline: true})
+	store, err = NewPlayerSnapshotStore(&offline, dir)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+
+	// The snapshot is used even though it is older than maxAge
+	store.now = func() time.Time { return time.Now().Add(48 * time.Hour) }
+	players, err := store.LoadOrRefresh(SportNFL, time.Hour)
+	if err != nil {
+		t.Fatalf("Expected saved snapshot, got %v", err)
+	}
+	if len(players) != 1 {
+		t.Errorf("Expected 1 player, got %d", len(players))
+	}
+
+	_, err = store.LoadOrRefresh(SportNBA, time.Hour)
+	if !errors.Is(err, ErrOfflineMiss) {
+		t.Errorf("Expected ErrOfflineMiss without a snapshot, got %v", err)
+	}
+
+	if hits.Load() != 1 {
+		t.Errorf("Expected 1 request, got %d", hits.Load())
+	}
+}
@@ -0,0 +1,313 @@
+package sleeper
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// PlayerDB indexes players for fast lookups by name, team, position, status
+// and the IDs used by other fantasy and stats providers.
+type PlayerDB struct {
+	players Players
+
+	byName            map[string][]string
+	byTeam            map[string][]string
+	byPosition        map[string][]string
+	byFantasyPosition map[string][]string
+	byStatus          map[string][]string
+
+	byEspnID       map[int]string
+	byYahooID      map[int]string
+	byRotowireID   map[int]string
+	byStatsID      map[int]string
+	byGsisID       map[string]string
+	bySportradarID map[string]string
+
+	names []playerName // Sorted by name for prefix search
+}
+
+type playerName struct {
+	name  string
+	first string
+	last  string
+	id    string
+}
+
+// NewPlayerDB builds the indexes for the players.
+func NewPlayerDB(players Players) *PlayerDB {
+	db := &PlayerDB{
+		players:           players,
+		byName:            make(map[string][]string),
+		byTeam:            make(map[string][]string),
+		byPosition:        make(map[string][]string),
+		byFantasyPosition: make(map[string][]string),
+		byStatus:          make(map[string][]string),
+		byEspnID:          make(map[int]string),
+		byYahooID:         make(map[int]string),
+		byRotowireID:      make(map[int]string),
+		byStatsID:         make(map[int]string),
+		byGsisID:          make(map[string]string),
+		bySportradarID:    make(map[string]string),
+	}
+
+	for id, p := range players {
+		name := playerSearchName(p)
+		if name != "" {
+			db.byName[name] = append(db.byName[name], id)
+			db.names = append(db.names, playerName{
+				name:  name,
+				first: normalizeName(cmp.Or(p.SearchFirstName, p.FirstName)),
+				last:  normalizeName(cmp.Or(p.SearchLastName, p.LastName)),
+				id:    id,
+			})
+		}
+
+		addIndex(db.byTeam, strings.ToUpper(p.Team), id)
+		addIndex(db.byPosition, strings.ToUpper(p.Position), id)
+		addIndex(db.byStatus, strings.ToLower(p.Status), id)
+		for _, pos := range p.FantasyPositions {
+			addIndex(db.byFantasyPosition, strings.ToUpper(pos), id)
+		}
+
+		addID(db.byEspnID, p.EspnID, id)
+		addID(db.byYahooID, p.YahooID, id)
+		addID(db.byRotowireID, p.RotowireID, id)
+		addID(db.byStatsID, p.StatsID, id)
+		addID(db.byGsisID, strings.TrimSpace(p.GsisID), id)
+		addID(db.bySportradarID, p.SportradarID, id)
+	}
+
+	slices.SortFunc(db.names, func(a, b playerName) int {
+		return cmp.Or(cmp.Compare(a.name, b.name), cmp.Compare(a.id, b.id))
+	})
+
+	return db
+}
+
+func addIndex(index map[string][]string, key string, id string) {
+	if key != "" {
+		index[key] = append(index[key], id)
+	}
+}
+
+func addID[K comparable](index map[K]string, key K, id string) {
+	var zero K
+	if key != zero {
+		index[key] = id
+	}
+}
+
+// Len returns the number of players in the database.
+func (db *PlayerDB) Len() int {
+	return len(db.players)
+}
+
+// Get returns the player with the Sleeper player ID.
+func (db *PlayerDB) Get(player_id string) (Player, bool) {
+	p, ok := db.players[player_id]
+	return p, ok
+}
+
+// ByName returns the players whose full name matches exactly, ignoring case, spaces and punctuation.
+func (db *PlayerDB) ByName(name string) []Player {
+	return db.lookup(db.byName[normalizeName(name)])
+}
+
+// ByTeam returns the players on the team, such as BUF.
+func (db *PlayerDB) ByTeam(team string) []Player {
+	return db.lookup(db.byTeam[strings.ToUpper(team)])
+}
+
+// ByPosition returns the players with the position, such as QB.
+func (db *PlayerDB) ByPosition(position string) []Player {
+	return db.lookup(db.byPosition[strings.ToUpper(position)])
+}
+
+// ByFantasyPosition returns the players eligible at the fantasy position, such as WR.
+func (db *PlayerDB) ByFantasyPosition(position string) []Player {
+	return db.lookup(db.byFantasyPosition[strings.ToUpper(position)])
+}
+
+// ByStatus returns the players with the status, such as Active or Inactive.
+func (db *PlayerDB) ByStatus(status string) []Player {
+	return db.lookup(db.byStatus[strings.ToLower(status)])
+}
+
+// ByEspnID returns the player with the ESPN ID.
+func (db *PlayerDB) ByEspnID(id int) (Player, bool) {
+	return db.Get(db.byEspnID[id])
+}
+
+// ByYahooID returns the player with the Yahoo ID.
+func (db *PlayerDB) ByYahooID(id int) (Player, bool) {
+	return db.Get(db.byYahooID[id])
+}
+
+// ByRotowireID returns the player with the Rotowire ID.
+func (db *PlayerDB) ByRotowireID(id int) (Player, bool) {
+	return db.Get(db.byRotowireID[id])
+}
+
+// ByStatsID returns the player with the STATS ID.
+func (db *PlayerDB) ByStatsID(id int) (Player, bool) {
+	return db.Get(db.byStatsID[id])
+}
+
+// ByGsisID returns the player with the NFL GSIS ID.
+func (db *PlayerDB) ByGsisID(id string) (Player, bool) {
+	return db.Get(db.byGsisID[strings.TrimSpace(id)])
+}
+
+// BySportradarID returns the player with the Sportradar ID.
+func (db *PlayerDB) BySportradarID(id string) (Player, bool) {
+	return db.Get(db.bySportradarID[id])
+}
+
+// Get the players for the IDs ordered by search rank.
+func (db *PlayerDB) lookup(ids []string) []Player {
+	players := make([]Player, 0, len(ids))
+	for _, id := range ids {
+		players = append(players, db.players[id])
+	}
+	slices.SortFunc(players, comparePlayerRank)
+	return players
+}
+
+// How closely a player's name matches a search query. Lower is better.
+const (
+	matchExact = iota
+	matchPrefix
+	matchPartPrefix
+	matchFuzzy
+	matchNone
+)
+
+// Search finds players by name. Exact matches are returned first, then names starting
+// with the query, then first or last names starting with the query, and finally names
+// within a small edit distance of the query. Players are ranked by SearchRank within
+// each group. A limit of zero or less returns every match.
+func (db *PlayerDB) Search(query string, limit int) []Player {
+	q := normalizeName(query)
+	if q == "" {
+		return nil
+	}
+
+	type result struct {
+		match  int
+		player Player
+	}
+
+	var results []result
+	seen := make(map[string]bool)
+	add := func(match int, id string) {
+		if !seen[id] {
+			seen[id] = true
+			results = append(results, result{match: match, player: db.players[id]})
+		}
+	}
+
+	// Prefix matches on the full name are found with a binary search
+	start, _ := slices.BinarySearchFunc(db.names, q, func(n playerName, q string) int {
+		return cmp.Compare(n.name, q)
+	})
+	for _, n := range db.names[start:] {
+		if !strings.HasPrefix(n.name, q) {
+			break
+		}
+		if n.name == q {
+			add(matchExact, n.id)
+		} else {
+			add(matchPrefix, n.id)
+		}
+	}
+
+	maxDistance := max(1, len(q)/4)
+	for _, n := range db.names {
+		if seen[n.id] {
+			continue
+		}
+		if strings.HasPrefix(n.first, q) || strings.HasPrefix(n.last, q) {
+			add(matchPartPrefix, n.id)
+		} else if abs(len(n.name)-len(q)) <= maxDistance && editDistance(n.name, q) <= maxDistance {
+			add(matchFuzzy, n.id)
+		}
+	}
+
+	slices.SortFunc(results, func(a, b result) int {
+		return cmp.Or(cmp.Compare(a.match, b.match), comparePlayerRank(a.player, b.player))
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	players := make([]Player, len(results))
+	for i, r := range results {
+		players[i] = r.player
+	}
+	return players
+}
+
+// Order players by search rank, treating a missing rank as the lowest.
+func comparePlayerRank(a Player, b Player) int {
+	rank := func(p Player) int {
+		if p.SearchRank <= 0 {
+			return int(^uint(0) >> 1)
+		}
+		return p.SearchRank
+	}
+	return cmp.Or(cmp.Compare(rank(a), rank(b)), cmp.Compare(a.PlayerID, b.PlayerID))
+}
+
+// Get the normalized full name used for searching.
+func playerSearchName(p Player) string {
+	if p.SearchFullName != "" {
+		return normalizeName(p.SearchFullName)
+	}
+	if p.FullName != "" {
+		return normalizeName(p.FullName)
+	}
+	return normalizeName(p.FirstName + p.LastName)
+}
+
+// Normalize a name the same way as Sleeper's search names: lowercase letters and digits only.
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// Levenshtein distance between two strings.
+func editDistance(a string, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
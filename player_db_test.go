@@ -0,0 +1,125 @@
+package sleeper
+
+import (
+	"testing"
+)
+
+func testPlayerDB() *PlayerDB {
+	return NewPlayerDB(Players{
+		"4984": {PlayerID: "4984", FirstName: "Josh", LastName: "Allen", SearchFullName: "joshallen", SearchRank: 5, Team: "BUF", Position: "QB", FantasyPositions: []string{"QB"}, Status: "Active", EspnID: 3918298, GsisID: " 00-0034857", SportradarID: "3069db07"},
+		"6794": {PlayerID: "6794", FirstName: "Justin", LastName: "Jefferson", SearchFullName: "justinjefferson", SearchRank: 2, Team: "MIN", Position: "WR", FantasyPositions: []string{"WR"}, Status: "Active", YahooID: 32692},
+		"2133": {PlayerID: "2133", FirstName: "Davante", LastName: "Adams", SearchFullName: "davanteadams", SearchRank: 40, Team: "LAR", Position: "WR", FantasyPositions: []string{"WR"}, Status: "Active", StatsID: 611417},
+		"5000": {PlayerID: "5000", FirstName: "Josh", LastName: "Allen", SearchFullName: "joshallen", SearchRank: 900, Team: "JAX", Position: "LB", FantasyPositions: []string{"LB", "DL"}, Status: "Inactive", RotowireID: 13005},
+		"7000": {PlayerID: "7000", FirstName: "Josh", LastName: "Jacobs", FullName: "Josh Jacobs", SearchRank: 20, Team: "GB", Position: "RB", FantasyPositions: []string{"RB"}, Status: "Active"},
+	})
+}
+
+func TestPlayerDBIndexes(t *testing.T) {
+	db := testPlayerDB()
+
+	if db.Len() != 5 {
+		t.Errorf("Expected 5 players, got %d", db.Len())
+	}
+
+	wrs := db.ByPosition("wr")
+	if len(wrs) != 2 || wrs[0].PlayerID != "6794" || wrs[1].PlayerID != "2133" {
+		t.Errorf("Expected WRs ordered by search rank, got %+v", wrs)
+	}
+
+	if got := db.ByTeam("buf"); len(got) != 1 || got[0].PlayerID != "4984" {
+		t.Errorf("Expected Josh Allen on BUF, got %+v", got)
+	}
+
+	if got := db.ByFantasyPosition("DL"); len(got) != 1 || got[0].PlayerID != "5000" {
+		t.Errorf("Expected 1 DL, got %+v", got)
+	}
+
+	if got := db.ByStatus("inactive"); len(got) != 1 || got[0].PlayerID != "5000" {
+		t.Errorf("Expected 1 inactive player, got %+v", got)
+	}
+
+	names := db.ByName("Josh Allen")
+	if len(names) != 2 || names[0].PlayerID != "4984" {
+		t.Errorf("Expected both Josh Allens ordered by search rank, got %+v", names)
+	}
+}
+
+func TestPlayerDBExternalIDs(t *testing.T) {
+	db := testPlayerDB()
+
+	tests := []struct {
+		name string
+		get  func() (Player, bool)
+		want string
+	}{
+		{"espn", func() (Player, bool) { return db.ByEspnID(3918298) }, "4984"},
+		{"yahoo", func() (Player, bool) { return db.ByYahooID(32692) }, "6794"},
+		{"stats", func() (Player, bool) { return db.ByStatsID(611417) }, "2133"},
+		{"rotowire", func() (Player, bool) { return db.ByRotowireID(13005) }, "5000"},
+		{"gsis", func() (Player, bool) { return db.ByGsisID("00-0034857") }, "4984"},
+		{"sportradar", func() (Player, bool) { return db.BySportradarID("3069db07") }, "4984"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, ok := tt.get()
+			if !ok || p.PlayerID != tt.want {
+				t.Errorf("Expected player %s, got %q (found %v)", tt.want, p.PlayerID, ok)
+			}
+		})
+	}
+
+	if _, ok := db.ByEspnID(0); ok {
+		t.Error("Expected no player for a zero ESPN ID")
+	}
+}
+
+func TestPlayerDBSearch(t *testing.T) {
+	db := testPlayerDB()
+
+	tests := []struct {
+		query string
+		limit int
+		want  []string
+	}{
+		{"Josh Allen", 0, []string{"4984", "5000"}},
+		{"josh", 0, []string{"4984", "7000", "5000"}},
+		{"jefferson", 0, []string{"6794"}},
+		{"davante adms", 0, []string{"2133"}},
+		{"josh", 1, []string{"4984"}},
+		{"zzz", 0, nil},
+		{"", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got := db.Search(tt.query, tt.limit)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected %d results, got %d: %+v", len(tt.want), len(got), got)
+			}
+			for i, id := range tt.want {
+				if got[i].PlayerID != id {
+					t.Errorf("Expected result %d to be %s, got %s", i, id, got[i].PlayerID)
+				}
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"davanteadams", "davanteadms", 1},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("Expected distance %d between %q and %q, got %d", tt.want, tt.a, tt.b, got)
+		}
+	}
+}
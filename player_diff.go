@@ -0,0 +1,140 @@
+package sleeper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// PlayerChangeKind is the kind of change found between two player snapshots.
+type PlayerChangeKind string
+
+const (
+	PlayerAdded                     PlayerChangeKind = "added"
+	PlayerTeamChanged               PlayerChangeKind = "team"
+	PlayerStatusChanged             PlayerChangeKind = "status"
+	PlayerInjuryStatusChanged       PlayerChangeKind = "injury_status"
+	PlayerInjuryBodyPartChanged     PlayerChangeKind = "injury_body_part"
+	PlayerPracticeChanged           PlayerChangeKind = "practice_participation"
+	PlayerDepthChartPositionChanged PlayerChangeKind = "depth_chart_position"
+	PlayerDepthChartOrderChanged    PlayerChangeKind = "depth_chart_order"
+)
+
+// PlayerChange is a single change to a player between two snapshots. Old and New hold
+// the previous and current values of the changed field, Old is empty for added players.
+type PlayerChange struct {
+	Kind     PlayerChangeKind
+	PlayerID string
+	Player   Player // The player from the newer snapshot
+	Old      string
+	New      string
+}
+
+// String describes the change in a sentence suitable for a notification.
+func (c PlayerChange) String() string {
+	name := playerDisplayName(c.Player)
+
+	switch c.Kind {
+	case PlayerAdded:
+		if c.Player.Team != "" {
+			return fmt.Sprintf("%s (%s %s) was added", name, c.Player.Team, c.Player.Position)
+		}
+		return fmt.Sprintf("%s was added", name)
+	case PlayerTeamChanged:
+		switch {
+		case c.Old == "":
+			return fmt.Sprintf("%s signed with %s", name, c.New)
+		case c.New == "":
+			return fmt.Sprintf("%s was released by %s", name, c.Old)
+		}
+		return fmt.Sprintf("%s moved from %s to %s", name, c.Old, c.New)
+	case PlayerStatusChanged:
+		return fmt.Sprintf("%s is now %s", name, valueOrNone(c.New))
+	case PlayerInjuryStatusChanged:
+		if c.New == "" {
+			return fmt.Sprintf("%s is no longer %s", name, c.Old)
+		}
+		return fmt.Sprintf("%s is now %s", name, c.New)
+	case PlayerInjuryBodyPartChanged:
+		return fmt.Sprintf("%s injury changed from %s to %s", name, valueOrNone(c.Old), valueOrNone(c.New))
+	case PlayerPracticeChanged:
+		return fmt.Sprintf("%s practice participation changed from %s to %s", name, valueOrNone(c.Old), valueOrNone(c.New))
+	case PlayerDepthChartPositionChanged:
+		return fmt.Sprintf("%s depth chart position changed from %s to %s", name, valueOrNone(c.Old), valueOrNone(c.New))
+	case PlayerDepthChartOrderChanged:
+		return fmt.Sprintf("%s depth chart order changed from %s to %s", name, valueOrNone(c.Old), valueOrNone(c.New))
+	}
+
+	return fmt.Sprintf("%s %s changed from %s to %s", name, c.Kind, valueOrNone(c.Old), valueOrNone(c.New))
+}
+
+// DiffPlayers compares two player snapshots and returns the changes from old to new,
+// ordered by player ID. Players that only appear in old are ignored.
+func DiffPlayers(old Players, new Players) []PlayerChange {
+	var changes []PlayerChange
+
+	for id, p := range new {
+		prev, ok := old[id]
+		if !ok {
+			changes = append(changes, PlayerChange{Kind: PlayerAdded, PlayerID: id, Player: p})
+			continue
+		}
+		changes = append(changes, diffPlayer(id, prev, p)...)
+	}
+
+	slices.SortStableFunc(changes, func(a, b PlayerChange) int {
+		return cmp.Compare(a.PlayerID, b.PlayerID)
+	})
+
+	return changes
+}
+
+// Compare the fields of a single player, in a fixed order.
+func diffPlayer(id string, old Player, new Player) []PlayerChange {
+	fields := []struct {
+		kind     PlayerChangeKind
+		old, new string
+	}{
+		{PlayerTeamChanged, old.Team, new.Team},
+		{PlayerStatusChanged, old.Status, new.Status},
+		{PlayerInjuryStatusChanged, old.InjuryStatus, new.InjuryStatus},
+		{PlayerInjuryBodyPartChanged, old.InjuryBodyPart, new.InjuryBodyPart},
+		{PlayerPracticeChanged, old.PracticeParticipation, new.PracticeParticipation},
+		{PlayerDepthChartPositionChanged, old.DepthChartPosition, new.DepthChartPosition},
+		{PlayerDepthChartOrderChanged, depthChartOrder(old), depthChartOrder(new)},
+	}
+
+	var changes []PlayerChange
+	for _, f := range fields {
+		if f.old != f.new {
+			changes = append(changes, PlayerChange{Kind: f.kind, PlayerID: id, Player: new, Old: f.old, New: f.new})
+		}
+	}
+
+	return changes
+}
+
+// Sleeper uses zero for players without a depth chart order.
+func depthChartOrder(p Player) string {
+	if p.DepthChartOrder == 0 {
+		return ""
+	}
+	return strconv.Itoa(p.DepthChartOrder)
+}
+
+// Get the name to show for a player, falling back to the ID.
+func playerDisplayName(p Player) string {
+	switch {
+	case p.FullName != "":
+		return p.FullName
+	case p.FirstName != "" || p.LastName != "":
+		return strings.TrimSpace(p.FirstName + " " + p.LastName)
+	}
+	return "Player " + p.PlayerID
+}
+
+func valueOrNone(value string) string {
+	return cmp.Or(value, "none")
+}
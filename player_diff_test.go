@@ -0,0 +1,69 @@
+package sleeper
+
+import (
+	"testing"
+)
+
+func TestDiffPlayers(t *testing.T) {
+	old := Players{
+		"1": {PlayerID: "1", FullName: "Stefon Diggs", Team: "BUF", Status: "Active", DepthChartPosition: "WR", DepthChartOrder: 1},
+		"2": {PlayerID: "2", FullName: "Joe Mixon", Team: "CIN", Status: "Active", InjuryStatus: "Questionable", InjuryBodyPart: "Ankle", PracticeParticipation: "Limited"},
+		"3": {PlayerID: "3", FullName: "Unchanged Player", Team: "KC"},
+		"4": {PlayerID: "4", FullName: "Retired Player", Team: "NE"},
+	}
+	new := Players{
+		"1": {PlayerID: "1", FullName: "Stefon Diggs", Team: "HOU", Status: "Active", DepthChartPosition: "WR", DepthChartOrder: 2},
+		"2": {PlayerID: "2", FullName: "Joe Mixon", Team: "CIN", Status: "Injured Reserve", InjuryStatus: "IR", InjuryBodyPart: "Knee", PracticeParticipation: "Out"},
+		"3": {PlayerID: "3", FullName: "Unchanged Player", Team: "KC"},
+		"5": {PlayerID: "5", FullName: "New Rookie", Team: "CHI", Position: "QB"},
+	}
+
+	changes := DiffPlayers(old, new)
+
+	want := []struct {
+		kind     PlayerChangeKind
+		id       string
+		old, new string
+		text     string
+	}{
+		{PlayerTeamChanged, "1", "BUF", "HOU", "Stefon Diggs moved from BUF to HOU"},
+		{PlayerDepthChartOrderChanged, "1", "1", "2", "Stefon Diggs depth chart order changed from 1 to 2"},
+		{PlayerStatusChanged, "2", "Active", "Injured Reserve", "Joe Mixon is now Injured Reserve"},
+		{PlayerInjuryStatusChanged, "2", "Questionable", "IR", "Joe Mixon is now IR"},
+		{PlayerInjuryBodyPartChanged, "2", "Ankle", "Knee", "Joe Mixon injury changed from Ankle to Knee"},
+		{PlayerPracticeChanged, "2", "Limited", "Out", "Joe Mixon practice participation changed from Limited to Out"},
+		{PlayerAdded, "5", "", "", "New Rookie (CHI QB) was added"},
+	}
+
+	if len(changes) != len(want) {
+		t.Fatalf("Expected %d changes, got %d: %+v", len(want), len(changes), changes)
+	}
+
+	for i, w := range want {
+		c := changes[i]
+		if c.Kind != w.kind || c.PlayerID != w.id || c.Old != w.old || c.New != w.new {
+			t.Errorf("Expected change %d to be %s %s %q -> %q, got %s %s %q -> %q", i, w.kind, w.id, w.old, w.new, c.Kind, c.PlayerID, c.Old, c.New)
+		}
+		if c.String() != w.text {
+			t.Errorf("Expected %q, got %q", w.text, c.String())
+		}
+	}
+}
+
+func TestPlayerChangeString(t *testing.T) {
+	tests := []struct {
+		change PlayerChange
+		want   string
+	}{
+		{PlayerChange{Kind: PlayerTeamChanged, Player: Player{FullName: "A"}, New: "DAL"}, "A signed with DAL"},
+		{PlayerChange{Kind: PlayerTeamChanged, Player: Player{FullName: "A"}, Old: "DAL"}, "A was released by DAL"},
+		{PlayerChange{Kind: PlayerInjuryStatusChanged, Player: Player{FirstName: "Buffalo", LastName: "Bills"}, Old: "Out"}, "Buffalo Bills is no longer Out"},
+		{PlayerChange{Kind: PlayerDepthChartPositionChanged, Player: Player{PlayerID: "9"}, New: "RB"}, "Player 9 depth chart position changed from none to RB"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.change.String(); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+}
@@ -1,14 +1,21 @@
 package sleeper
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"reflect"
 )
 
 type TrendingPlayer struct {
 	Count    int    `json:"count"`
 	PlayerID string `json:"player_id"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type Players map[string]Player
@@ -57,7 +64,7 @@ type Player struct {
 	SearchFullName        string      `json:"search_full_name"`
 	SearchLastName        string      `json:"search_last_name"`
 	SearchRank            int         `json:"search_rank"`
-	Sport                 string      `json:"sport"`
+	Sport                 Sport       `json:"sport"`
 	SportradarID          string      `json:"sportradar_id"`
 	StatsID               int         `json:"stats_id"`
 	Status                string      `json:"status"`
@@ -68,6 +75,23 @@ type Player struct {
 	Weight                string      `json:"weight"`
 	YahooID               int         `json:"yahoo_id"`
 	YearsExp              int         `json:"years_exp"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the player was decoded from, not set on players from GetAllPlayers
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the player, not set on players from GetAllPlayers
+}
+
+// DecodePlayer decodes a single player and keeps the JSON it was decoded from in Raw and
+// the fields it does not declare in Unknown. GetPlayer, DecodePlayers, and StreamAllPlayers
+// decode players this way. Players in the full list from GetAllPlayers leave both empty
+// so the list stays small in memory.
+func DecodePlayer(data []byte) (Player, error) {
+	player := Player{}
+	if err := json.Unmarshal(data, &player); err != nil {
+		return player, err
+	}
+
+	keepRaw(data, reflect.TypeFor[Player](), &player.Raw, &player.Unknown)
+	return player, nil
 }
 
 // Get all players.
@@ -75,17 +99,26 @@ type Player struct {
 //
 // You should save this information on your own servers as this is not intended to be called every time you need to look up players due to the filesize being close to 5MB in size.
 // You do not need to call this endpoint more than once per day.
-func (c *Client) GetAllPlayers(sport string) (Players, error) {
+func (c *Client) GetAllPlayers(sport Sport) (Players, error) {
+	return c.GetAllPlayersContext(context.Background(), sport)
+}
+
+// GetAllPlayersContext is like GetAllPlayers but accepts a context.
+func (c *Client) GetAllPlayersContext(ctx context.Context, sport Sport) (Players, error) {
 	players := Players{}
 
+	if err := sport.Validate(); err != nil {
+		return players, err
+	}
+
 	url := fmt.Sprintf("%s/v1/players/%s", c.sleeperURL, sport)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return players, err
 	}
 
-	err = json.Unmarshal(data, &players)
+	err = c.decodeJSON(ctx, url, data, &players)
 
 	return players, err
 }
@@ -95,21 +128,30 @@ func (c *Client) GetAllPlayers(sport string) (Players, error) {
 //
 // You should save this information on your own servers as this is not intended to be called every time you need to look up players due to the filesize being close to 5MB in size.
 // You do not need to call this endpoint more than once per day.
-func (c *Client) SaveAllPlayers(sport string, file string) (bool, error) {
-	url := fmt.Sprintf("%s/v1/players/%s", c.sleeperURL, sport)
+func (c *Client) SaveAllPlayers(sport Sport, file string) (bool, error) {
+	return c.SaveAllPlayersContext(context.Background(), sport, file)
+}
 
-	data, err := c.getRequest(url)
-	if err != nil {
+// SaveAllPlayersContext is like SaveAllPlayers but accepts a context.
+func (c *Client) SaveAllPlayersContext(ctx context.Context, sport Sport, file string) (bool, error) {
+	if err := sport.Validate(); err != nil {
 		return false, err
 	}
 
-	f, err := os.Create(file)
+	url := fmt.Sprintf("%s/v1/players/%s", c.sleeperURL, sport)
+
+	// Stream the response straight to disk instead of buffering it
+	body, err := c.getStreamContext(ctx, url)
 	if err != nil {
 		return false, err
 	}
-	defer f.Close()
+	defer body.Close()
 
-	_, err = f.Write(data)
+	// Write to a temporary file first so a failed download never leaves a truncated file
+	err = writeFileAtomic(file, func(w io.Writer) error {
+		_, err := io.Copy(w, body)
+		return err
+	})
 	if err != nil {
 		return false, err
 	}
@@ -121,46 +163,159 @@ func (c *Client) SaveAllPlayers(sport string, file string) (bool, error) {
 func GetAllPlayers(file string) (Players, error) {
 	players := Players{}
 
-	data, err := os.ReadFile(file)
+	f, err := os.Open(file)
 	if err != nil {
 		return players, err
 	}
+	defer f.Close()
 
-	err = json.Unmarshal(data, &players)
+	err = json.NewDecoder(f).Decode(&players)
 
 	return players, err
 }
 
+// Stream all players, calling fn for each player as it is decoded instead of loading
+// every player into memory. Returning an error from fn stops the stream and returns the error.
+// (GET `https://api.sleeper.app/v1/players/<sport>`)
+func (c *Client) StreamAllPlayers(sport Sport, fn func(id string, player Player) error) error {
+	return c.StreamAllPlayersContext(context.Background(), sport, fn)
+}
+
+// StreamAllPlayersContext is like StreamAllPlayers but accepts a context.
+func (c *Client) StreamAllPlayersContext(ctx context.Context, sport Sport, fn func(id string, player Player) error) error {
+	if err := sport.Validate(); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v1/players/%s", c.sleeperURL, sport)
+
+	body, err := c.getStreamContext(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	err = DecodePlayers(body, fn)
+	if err != nil {
+		c.debug(ctx, "sleeper: failed to decode players stream", c.urlAttr(url), slog.Any("error", err))
+	}
+
+	return err
+}
+
+// Stream all players from a file saved with SaveAllPlayers, calling fn for each player.
+func StreamAllPlayers(file string, fn func(id string, player Player) error) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return DecodePlayers(f, fn)
+}
+
+// DecodePlayers decodes a JSON object of players keyed by player ID from the reader,
+// calling fn for each player as it is decoded with DecodePlayer. Returning an error from
+// fn stops decoding and returns the error.
+func DecodePlayers(r io.Reader, fn func(id string, player Player) error) error {
+	dec := json.NewDecoder(r)
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		id, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("invalid players JSON: expected player ID, got %v", token)
+		}
+
+		// Keep the raw JSON of each player, which only costs memory for one player at a time
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("invalid player %s: %w", id, err)
+		}
+
+		player, err := DecodePlayer(raw)
+		if err != nil {
+			return fmt.Errorf("invalid player %s: %w", id, err)
+		}
+
+		if err := fn(id, player); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+// Read the next token and check that it is the delimiter.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if d, ok := token.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("invalid players JSON: expected %v, got %v", delim, token)
+	}
+
+	return nil
+}
+
 // Get a list of trending players based on adds or drops in the past 24 hours. Trending type is add or drop.
 // (GET `https://api.sleeper.app/v1/players/<sport>/trending/<type>`)
-func (c *Client) GetTrendingPlayers(sport string, trending_type string) ([]TrendingPlayer, error) {
+func (c *Client) GetTrendingPlayers(sport Sport, trending_type string) ([]TrendingPlayer, error) {
+	return c.GetTrendingPlayersContext(context.Background(), sport, trending_type)
+}
+
+// GetTrendingPlayersContext is like GetTrendingPlayers but accepts a context.
+func (c *Client) GetTrendingPlayersContext(ctx context.Context, sport Sport, trending_type string) ([]TrendingPlayer, error) {
 	trendingPlayer := []TrendingPlayer{}
 
+	if err := sport.Validate(); err != nil {
+		return trendingPlayer, err
+	}
+
 	url := fmt.Sprintf("%s/v1/players/%s/trending/%s", c.sleeperURL, sport, trending_type)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return trendingPlayer, err
 	}
 
-	err = json.Unmarshal(data, &trendingPlayer)
+	err = c.decodeJSON(ctx, url, data, &trendingPlayer)
 
 	return trendingPlayer, err
 }
 
 // Get a list of trending players based on adds or drops in the past X hours. Trending type is add or drop.
 // (GET `https://api.sleeper.app/v1/players/<sport>/trending/<type>?lookback_hours=<hours>&limit=<int>`)
-func (c *Client) GetTrendingPlayersParams(sport string, trending_type string, hours int, limit int) ([]TrendingPlayer, error) {
+func (c *Client) GetTrendingPlayersParams(sport Sport, trending_type string, hours int, limit int) ([]TrendingPlayer, error) {
+	return c.GetTrendingPlayersParamsContext(context.Background(), sport, trending_type, hours, limit)
+}
+
+// GetTrendingPlayersParamsContext is like GetTrendingPlayersParams but accepts a context.
+func (c *Client) GetTrendingPlayersParamsContext(ctx context.Context, sport Sport, trending_type string, hours int, limit int) ([]TrendingPlayer, error) {
 	trendingPlayer := []TrendingPlayer{}
 
+	if err := sport.Validate(); err != nil {
+		return trendingPlayer, err
+	}
+
 	url := fmt.Sprintf("%s/v1/players/%s/trending/%s?loopback_hours=%d&limit=%d", c.sleeperURL, sport, trending_type, hours, limit)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return trendingPlayer, err
 	}
 
-	err = json.Unmarshal(data, &trendingPlayer)
+	err = c.decodeJSON(ctx, url, data, &trendingPlayer)
 
 	return trendingPlayer, err
 }
@@ -2,10 +2,12 @@ package sleeper
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -243,3 +245,86 @@ func TestGetTrendingPlayersParams(t *testing.T) {
 		t.Errorf("Expected count 100, got %d", trendingPlayers[0].Count)
 	}
 }
+
+func TestStreamAllPlayers(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"1": {"player_id": "1", "full_name": "Test Player 1"}, "2": {"player_id": "2", "full_name": "Test Player 2"}, "3": {"player_id": "3"}}`))
+	}))
+	defer ts.Close()
+
+	var events []RequestEvent
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Observer: ObserverFunc(func(event RequestEvent) {
+			events = append(events, event)
+		}),
+	})
+
+	names := map[string]string{}
+	err := client.StreamAllPlayers("nfl", func(id string, player Player) error {
+		names[id] = player.FullName
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(names) != 3 || names["2"] != "Test Player 2" {
+		t.Errorf("Unexpected players %v", names)
+	}
+	if len(events) != 1 || events[0].Endpoint != EndpointPlayers || events[0].Bytes == 0 {
+		t.Errorf("Expected one observed request with bytes, got %+v", events)
+	}
+
+	// Returning an error stops the stream
+	errStop := errors.New("stop")
+	count := 0
+	err = client.StreamAllPlayers("nfl", func(id string, player Player) error {
+		count++
+		return errStop
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("Expected stop error, got %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 player before stopping, got %d", count)
+	}
+}
+
+func TestStreamAllPlayersFromFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "players.json")
+	err := os.WriteFile(filePath, []byte(`{"1": {"player_id": "1", "team": "BUF"}, "2": {"player_id": "2", "team": "HOU"}}`), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write mock data to file: %v", err)
+	}
+
+	teams := map[string]string{}
+	err = StreamAllPlayers(filePath, func(id string, player Player) error {
+		teams[id] = player.Team
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if teams["1"] != "BUF" || teams["2"] != "HOU" {
+		t.Errorf("Unexpected teams %v", teams)
+	}
+}
+
+func TestDecodePlayersInvalid(t *testing.T) {
+	tests := []string{
+		`[]`,
+		`{"1": {"player_id": 5}}`,
+		`{"1": {"player_id": "1"}`,
+		``,
+	}
+
+	for _, input := range tests {
+		err := DecodePlayers(strings.NewReader(input), func(id string, player Player) error {
+			return nil
+		})
+		if err == nil {
+			t.Errorf("Expected error decoding %q", input)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package sleeper
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Histogram buckets in seconds for request durations and rate limiter waits.
+var prometheusBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+type prometheusHistogram struct {
+	counts []uint64 // Count per bucket, not cumulative
+	sum    float64
+	count  uint64
+}
+
+func (h *prometheusHistogram) observe(seconds float64) {
+	if h.counts == nil {
+		h.counts = make([]uint64, len(prometheusBuckets))
+	}
+
+	if i, _ := slices.BinarySearch(prometheusBuckets, seconds); i < len(prometheusBuckets) {
+		h.counts[i]++
+	}
+	h.sum += seconds
+	h.count++
+}
+
+type prometheusRequestKey struct {
+	endpoint string
+	status   string
+}
+
+// PrometheusObserver is an Observer that collects request metrics and serves them in
+// the Prometheus text exposition format. Mount it as an http.Handler to scrape it.
+//
+// The metrics include the number of requests sent to Sleeper in the last minute, to
+// compare against the limit of 1000 calls per minute.
+type PrometheusObserver struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	requests  map[prometheusRequestKey]uint64
+	attempts  map[string]uint64
+	retries   map[string]uint64
+	cacheHits map[string]uint64
+	shared    map[string]uint64
+	bytes     map[string]uint64
+	durations map[string]*prometheusHistogram
+	waits     map[string]*prometheusHistogram
+	recent    []time.Time
+}
+
+// NewPrometheusObserver creates an observer with no recorded metrics.
+func NewPrometheusObserver() *PrometheusObserver {
+	return &PrometheusObserver{
+		now:       time.Now,
+		requests:  make(map[prometheusRequestKey]uint64),
+		attempts:  make(map[string]uint64),
+		retries:   make(map[string]uint64),
+		cacheHits: make(map[string]uint64),
+		shared:    make(map[string]uint64),
+		bytes:     make(map[string]uint64),
+		durations: make(map[string]*prometheusHistogram),
+		waits:     make(map[string]*prometheusHistogram),
+	}
+}
+
+// ObserveRequest records the metrics for the request.
+func (p *PrometheusObserver) ObserveRequest(event RequestEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := "error"
+	if event.StatusCode != 0 {
+		status = strconv.Itoa(event.StatusCode)
+	}
+
+	p.requests[prometheusRequestKey{endpoint: event.Endpoint, status: status}]++
+	p.attempts[event.Endpoint] += uint64(event.Attempts)
+	p.retries[event.Endpoint] += uint64(event.Retries())
+	p.bytes[event.Endpoint] += uint64(event.Bytes)
+	if event.Cached {
+		p.cacheHits[event.Endpoint]++
+	}
+	if event.Shared {
+		p.shared[event.Endpoint]++
+	}
+
+	if p.durations[event.Endpoint] == nil {
+		p.durations[event.Endpoint] = &prometheusHistogram{}
+		p.waits[event.Endpoint] = &prometheusHistogram{}
+	}
+	p.durations[event.Endpoint].observe(event.Duration.Seconds())
+	p.waits[event.Endpoint].observe(event.LimiterWait.Seconds())
+
+	now := p.now()
+	for i := 0; i < event.Attempts; i++ {
+		p.recent = append(p.recent, now)
+	}
+	p.pruneRecent(now)
+}
+
+// Remove request times older than one minute.
+func (p *PrometheusObserver) pruneRecent(now time.Time) {
+	cutoff := now.Add(-time.Minute)
+	i := 0
+	for i < len(p.recent) && !p.recent[i].After(cutoff) {
+		i++
+	}
+	p.recent = p.recent[i:]
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (p *PrometheusObserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	p.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format.
+func (p *PrometheusObserver) WriteTo(w io.Writer) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.pruneRecent(p.now())
+
+	var b strings.Builder
+
+	writeHeader(&b, "sleeper_requests_total", "counter", "Requests made by the client by endpoint and final status code.")
+	keys := make([]prometheusRequestKey, 0, len(p.requests))
+	for key := range p.requests {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b prometheusRequestKey) int {
+		return strings.Compare(a.endpoint+" "+a.status, b.endpoint+" "+b.status)
+	})
+	for _, key := range keys {
+		fmt.Fprintf(&b, "sleeper_requests_total{endpoint=\"%s\",status=\"%s\"} %d\n", escapeLabel(key.endpoint), key.status, p.requests[key])
+	}
+
+	writeCounter(&b, "sleeper_upstream_requests_total", "HTTP requests sent to Sleeper, including retries.", p.attempts)
+	writeCounter(&b, "sleeper_retries_total", "Requests retried after a failed attempt.", p.retries)
+	writeCounter(&b, "sleeper_cache_hits_total", "Requests served from the cache.", p.cacheHits)
+	writeCounter(&b, "sleeper_shared_responses_total", "Requests answered by a concurrent request for the same URL.", p.shared)
+	writeCounter(&b, "sleeper_response_bytes_total", "Bytes of response bodies returned to the caller.", p.bytes)
+
+	writeHeader(&b, "sleeper_upstream_requests_last_minute", "gauge", "HTTP requests sent to Sleeper in the last minute.")
+	fmt.Fprintf(&b, "sleeper_upstream_requests_last_minute %d\n", len(p.recent))
+
+	writeHistogram(&b, "sleeper_request_duration_seconds", "Total time spent on requests, including rate limiter waits and retries.", p.durations)
+	writeHistogram(&b, "sleeper_rate_limiter_wait_seconds", "Time spent waiting for the rate limiter.", p.waits)
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func writeHeader(b *strings.Builder, name string, kind string, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func writeCounter(b *strings.Builder, name string, help string, values map[string]uint64) {
+	writeHeader(b, name, "counter", help)
+	for _, endpoint := range sortedKeys(values) {
+		fmt.Fprintf(b, "%s{endpoint=\"%s\"} %d\n", name, escapeLabel(endpoint), values[endpoint])
+	}
+}
+
+func writeHistogram(b *strings.Builder, name string, help string, values map[string]*prometheusHistogram) {
+	writeHeader(b, name, "histogram", help)
+	for _, endpoint := range sortedKeys(values) {
+		h := values[endpoint]
+		label := escapeLabel(endpoint)
+
+		cumulative := uint64(0)
+		for i, bucket := range prometheusBuckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(b, "%s_bucket{endpoint=\"%s\",le=\"%s\"} %d\n", name, label, formatFloat(bucket), cumulative)
+		}
+		fmt.Fprintf(b, "%s_bucket{endpoint=\"%s\",le=\"+Inf\"} %d\n", name, label, h.count)
+		fmt.Fprintf(b, "%s_sum{endpoint=\"%s\"} %s\n", name, label, formatFloat(h.sum))
+		fmt.Fprintf(b, "%s_count{endpoint=\"%s\"} %d\n", name, label, h.count)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// Escape a label value for the text exposition format.
+func escapeLabel(value string) string {
+	return labelEscaper.Replace(value)
+}
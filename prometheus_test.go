@@ -0,0 +1,88 @@
+package sleeper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPrometheusObserver(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	observer := NewPrometheusObserver()
+	observer.now = func() time.Time { return now }
+
+	observer.ObserveRequest(RequestEvent{
+		Endpoint:    EndpointMatchups,
+		StatusCode:  200,
+		Bytes:       100,
+		Attempts:    2,
+		Duration:    300 * time.Millisecond,
+		LimiterWait: 60 * time.Millisecond,
+	})
+	observer.ObserveRequest(RequestEvent{
+		Endpoint:   EndpointMatchups,
+		StatusCode: 200,
+		Bytes:      100,
+		Cached:     true,
+	})
+	observer.ObserveRequest(RequestEvent{
+		Endpoint:   EndpointSportState,
+		StatusCode: 200,
+		Shared:     true,
+	})
+	observer.ObserveRequest(RequestEvent{
+		Endpoint: EndpointLeague,
+		Attempts: 1,
+		Duration: 2 * time.Second,
+	})
+
+	rec := httptest.NewRecorder()
+	observer.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Errorf("Unexpected content type %s", rec.Header().Get("Content-Type"))
+	}
+
+	expected := []string{
+		`# TYPE sleeper_requests_total counter`,
+		`sleeper_requests_total{endpoint="/v1/league/:league_id",status="error"} 1`,
+		`sleeper_requests_total{endpoint="/v1/league/:league_id/matchups/:week",status="200"} 2`,
+		`sleeper_upstream_requests_total{endpoint="/v1/league/:league_id/matchups/:week"} 2`,
+		`sleeper_retries_total{endpoint="/v1/league/:league_id/matchups/:week"} 1`,
+		`sleeper_cache_hits_total{endpoint="/v1/league/:league_id/matchups/:week"} 1`,
+		`sleeper_response_bytes_total{endpoint="/v1/league/:league_id/matchups/:week"} 200`,
+		`sleeper_shared_responses_total{endpoint="/v1/state/:sport"} 1`,
+		`sleeper_upstream_requests_last_minute 3`,
+		`# TYPE sleeper_request_duration_seconds histogram`,
+		`sleeper_request_duration_seconds_bucket{endpoint="/v1/league/:league_id/matchups/:week",le="0.25"} 1`,
+		`sleeper_request_duration_seconds_bucket{endpoint="/v1/league/:league_id/matchups/:week",le="0.5"} 2`,
+		`sleeper_request_duration_seconds_bucket{endpoint="/v1/league/:league_id",le="1"} 0`,
+		`sleeper_request_duration_seconds_bucket{endpoint="/v1/league/:league_id",le="2.5"} 1`,
+		`sleeper_request_duration_seconds_bucket{endpoint="/v1/league/:league_id",le="+Inf"} 1`,
+		`sleeper_request_duration_seconds_sum{endpoint="/v1/league/:league_id"} 2`,
+		`sleeper_request_duration_seconds_count{endpoint="/v1/league/:league_id/matchups/:week"} 2`,
+		`sleeper_rate_limiter_wait_seconds_bucket{endpoint="/v1/league/:league_id/matchups/:week",le="0.1"} 2`,
+	}
+	for _, line := range expected {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("Expected metrics to contain %q\n%s", line, body)
+		}
+	}
+
+	// Requests older than a minute no longer count toward the budget
+	now = now.Add(61 * time.Second)
+	var b strings.Builder
+	observer.WriteTo(&b)
+	if !strings.Contains(b.String(), "sleeper_upstream_requests_last_minute 0\n") {
+		t.Errorf("Expected no requests in the last minute\n%s", b.String())
+	}
+}
+
+func TestEscapeLabel(t *testing.T) {
+	if got := escapeLabel("a\"b\\c\nd"); got != `a\"b\\c\nd` {
+		t.Errorf("Unexpected escaped label %s", got)
+	}
+}
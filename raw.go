@@ -0,0 +1,332 @@
+package sleeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Models keep the JSON they were decoded from in Raw and any fields they do not declare
+// in Unknown, so new fields added by Sleeper can be read before the library supports
+// them. Unknown fields inside nested objects are keyed by their dotted path, such as
+// "settings.new_setting". Encoding a model writes the unknown fields back out.
+
+// Decode data into v, a pointer to a type without its own UnmarshalJSON method, and
+// collect the raw data and unknown fields of the model.
+func unmarshalModel(data []byte, v any, raw *json.RawMessage, unknown *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	// A null model leaves the fields untouched like the default decoder
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	keepRaw(data, reflect.TypeOf(v).Elem(), raw, unknown)
+	return nil
+}
+
+// Set raw to a copy of data and unknown to the fields of data not declared on the struct type.
+func keepRaw(data []byte, t reflect.Type, raw *json.RawMessage, unknown *map[string]json.RawMessage) {
+	*raw = bytes.Clone(data)
+	*unknown = nil
+
+	fields := make(map[string]json.RawMessage)
+	collectUnknown(data, t, "", fields)
+	if len(fields) > 0 {
+		*unknown = fields
+	}
+}
+
+// Add the fields of the JSON object that are not declared on the struct type to fields,
+// descending into nested structs that do not decode themselves.
+func collectUnknown(data []byte, t reflect.Type, prefix string, fields map[string]json.RawMessage) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return
+	}
+
+	known := knownFields(t)
+	for key, value := range obj {
+		ft, ok := known[strings.ToLower(key)]
+		if !ok {
+			fields[prefix+key] = value
+			continue
+		}
+
+		if ft.Kind() == reflect.Struct && !reflect.PointerTo(ft).Implements(unmarshalerType) {
+			collectUnknown(value, ft, prefix+key+".", fields)
+		}
+	}
+}
+
+var (
+	unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	knownFieldCache sync.Map // reflect.Type -> map[string]reflect.Type
+)
+
+// Get the lowercase JSON names of the struct's fields and their types. The decoder
+// matches names without case, so unknown fields are found the same way.
+func knownFields(t reflect.Type) map[string]reflect.Type {
+	if fields, ok := knownFieldCache.Load(t); ok {
+		return fields.(map[string]reflect.Type)
+	}
+
+	fields := make(map[string]reflect.Type)
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		fields[strings.ToLower(name)] = ft
+	}
+
+	knownFieldCache.Store(t, fields)
+	return fields
+}
+
+// Encode v, a value of a type without its own MarshalJSON method, and add the unknown fields.
+func marshalModel(v any, unknown map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(unknown) == 0 {
+		return data, err
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+
+	for path, value := range unknown {
+		if err := setPath(obj, strings.Split(path, "."), value); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(obj)
+}
+
+// Set the value at the path in the JSON object, creating nested objects as needed.
+// Values of declared fields are not replaced.
+func setPath(obj map[string]json.RawMessage, path []string, value json.RawMessage) error {
+	key := path[0]
+	if len(path) == 1 {
+		if _, ok := obj[key]; !ok {
+			obj[key] = value
+		}
+		return nil
+	}
+
+	var nested map[string]json.RawMessage
+	if existing, ok := obj[key]; ok && !bytes.Equal(existing, []byte("null")) {
+		if err := json.Unmarshal(existing, &nested); err != nil {
+			// Declared field is not an object, keep its value
+			return nil
+		}
+	}
+	if nested == nil {
+		nested = make(map[string]json.RawMessage)
+	}
+
+	if err := setPath(nested, path[1:], value); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(nested)
+	if err != nil {
+		return err
+	}
+	obj[key] = data
+	return nil
+}
+
+func (l *League) UnmarshalJSON(data []byte) error {
+	type league League
+	return unmarshalModel(data, (*league)(l), &l.Raw, &l.Unknown)
+}
+
+func (l League) MarshalJSON() ([]byte, error) {
+	type league League
+	return marshalModel(league(l), l.Unknown)
+}
+
+func (r *Roster) UnmarshalJSON(data []byte) error {
+	type roster Roster
+	return unmarshalModel(data, (*roster)(r), &r.Raw, &r.Unknown)
+}
+
+func (r Roster) MarshalJSON() ([]byte, error) {
+	type roster Roster
+	return marshalModel(roster(r), r.Unknown)
+}
+
+func (u *LeagueUser) UnmarshalJSON(data []byte) error {
+	type leagueUser LeagueUser
+	return unmarshalModel(data, (*leagueUser)(u), &u.Raw, &u.Unknown)
+}
+
+func (u LeagueUser) MarshalJSON() ([]byte, error) {
+	type leagueUser LeagueUser
+	return marshalModel(leagueUser(u), u.Unknown)
+}
+
+func (m *Matchup) UnmarshalJSON(data []byte) error {
+	type matchup Matchup
+	return unmarshalModel(data, (*matchup)(m), &m.Raw, &m.Unknown)
+}
+
+func (m Matchup) MarshalJSON() ([]byte, error) {
+	type matchup Matchup
+	return marshalModel(matchup(m), m.Unknown)
+}
+
+func (d *Draft) UnmarshalJSON(data []byte) error {
+	type draft Draft
+	return unmarshalModel(data, (*draft)(d), &d.Raw, &d.Unknown)
+}
+
+func (d Draft) MarshalJSON() ([]byte, error) {
+	type draft Draft
+	return marshalModel(draft(d), d.Unknown)
+}
+
+func (d *DraftPlayer) UnmarshalJSON(data []byte) error {
+	type draftPlayer DraftPlayer
+	return unmarshalModel(data, (*draftPlayer)(d), &d.Raw, &d.Unknown)
+}
+
+func (d DraftPlayer) MarshalJSON() ([]byte, error) {
+	type draftPlayer DraftPlayer
+	return marshalModel(draftPlayer(d), d.Unknown)
+}
+
+func (p Player) MarshalJSON() ([]byte, error) {
+	type player Player
+	return marshalModel(player(p), p.Unknown)
+}
+
+func (p *Projection) UnmarshalJSON(data []byte) error {
+	type projection Projection
+	return unmarshalModel(data, (*projection)(p), &p.Raw, &p.Unknown)
+}
+
+func (p Projection) MarshalJSON() ([]byte, error) {
+	type projection Projection
+	return marshalModel(projection(p), p.Unknown)
+}
+
+func (s *PlayerStats) UnmarshalJSON(data []byte) error {
+	type playerStats PlayerStats
+	return unmarshalModel(data, (*playerStats)(s), &s.Raw, &s.Unknown)
+}
+
+func (s PlayerStats) MarshalJSON() ([]byte, error) {
+	type playerStats PlayerStats
+	return marshalModel(playerStats(s), s.Unknown)
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type user User
+	return unmarshalModel(data, (*user)(u), &u.Raw, &u.Unknown)
+}
+
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	return marshalModel(user(u), u.Unknown)
+}
+
+func (p *PlayoffRound) UnmarshalJSON(data []byte) error {
+	type playoffRound PlayoffRound
+	return unmarshalModel(data, (*playoffRound)(p), &p.Raw, &p.Unknown)
+}
+
+func (p PlayoffRound) MarshalJSON() ([]byte, error) {
+	type playoffRound PlayoffRound
+	return marshalModel(playoffRound(p), p.Unknown)
+}
+
+func (t *Transaction) UnmarshalJSON(data []byte) error {
+	type transaction Transaction
+	return unmarshalModel(data, (*transaction)(t), &t.Raw, &t.Unknown)
+}
+
+func (t Transaction) MarshalJSON() ([]byte, error) {
+	type transaction Transaction
+	return marshalModel(transaction(t), t.Unknown)
+}
+
+func (s *SportState) UnmarshalJSON(data []byte) error {
+	type sportState SportState
+	return unmarshalModel(data, (*sportState)(s), &s.Raw, &s.Unknown)
+}
+
+func (s SportState) MarshalJSON() ([]byte, error) {
+	type sportState SportState
+	return marshalModel(sportState(s), s.Unknown)
+}
+
+func (t *TradedPick) UnmarshalJSON(data []byte) error {
+	type tradedPick TradedPick
+	return unmarshalModel(data, (*tradedPick)(t), &t.Raw, &t.Unknown)
+}
+
+func (t TradedPick) MarshalJSON() ([]byte, error) {
+	type tradedPick TradedPick
+	return marshalModel(tradedPick(t), t.Unknown)
+}
+
+func (t *TrendingPlayer) UnmarshalJSON(data []byte) error {
+	type trendingPlayer TrendingPlayer
+	return unmarshalModel(data, (*trendingPlayer)(t), &t.Raw, &t.Unknown)
+}
+
+func (t TrendingPlayer) MarshalJSON() ([]byte, error) {
+	type trendingPlayer TrendingPlayer
+	return marshalModel(trendingPlayer(t), t.Unknown)
+}
+
+func (p *PlayerResearch) UnmarshalJSON(data []byte) error {
+	type playerResearch PlayerResearch
+	return unmarshalModel(data, (*playerResearch)(p), &p.Raw, &p.Unknown)
+}
+
+func (p PlayerResearch) MarshalJSON() ([]byte, error) {
+	type playerResearch PlayerResearch
+	return marshalModel(playerResearch(p), p.Unknown)
+}
+
+func (t *TeamDepthChart) UnmarshalJSON(data []byte) error {
+	type teamDepthChart TeamDepthChart
+	return unmarshalModel(data, (*teamDepthChart)(t), &t.Raw, &t.Unknown)
+}
+
+func (t TeamDepthChart) MarshalJSON() ([]byte, error) {
+	type teamDepthChart TeamDepthChart
+	return marshalModel(teamDepthChart(t), t.Unknown)
+}
+
+func (s *ScheduleGame) UnmarshalJSON(data []byte) error {
+	type scheduleGame ScheduleGame
+	return unmarshalModel(data, (*scheduleGame)(s), &s.Raw, &s.Unknown)
+}
+
+func (s ScheduleGame) MarshalJSON() ([]byte, error) {
+	type scheduleGame ScheduleGame
+	return marshalModel(scheduleGame(s), s.Unknown)
+}
@@ -0,0 +1,189 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestModelUnknownFields(t *testing.T) {
+	data := []byte(`{"league_id":"1","name":"Test","new_field":{"a":1},"settings":{"playoff_teams":6,"new_setting":2},"scoring_settings":{"rec":1,"bonus_new":0.5}}`)
+
+	var league League
+	if err := json.Unmarshal(data, &league); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if league.LeagueID != "1" || league.Settings.PlayoffTeams != 6 {
+		t.Errorf("Expected declared fields to decode, got %+v", league)
+	}
+	if string(league.Raw) != string(data) {
+		t.Errorf("Expected raw %s, got %s", data, league.Raw)
+	}
+
+	want := map[string]string{
+		"new_field":                  `{"a":1}`,
+		"settings.new_setting":       `2`,
+		"scoring_settings.bonus_new": `0.5`,
+	}
+	if len(league.Unknown) != len(want) {
+		t.Errorf("Expected %d unknown fields, got %v", len(want), league.Unknown)
+	}
+	for key, value := range want {
+		if string(league.Unknown[key]) != value {
+			t.Errorf("Expected unknown %s to be %s, got %s", key, value, league.Unknown[key])
+		}
+	}
+}
+
+func TestModelRoundTrip(t *testing.T) {
+	data := []byte(`{"player_id":"4984","full_name":"Josh Allen","metadata":{"rookie_year":"2018","new_meta":"x"},"brand_new":[1,2,3]}`)
+
+	player, err := DecodePlayer(data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	player.Team = "BUF"
+	out, err := json.Marshal(player)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if decoded["team"] != "BUF" {
+		t.Errorf("Expected changed team to be encoded, got %v", decoded["team"])
+	}
+	if b, _ := json.Marshal(decoded["brand_new"]); string(b) != `[1,2,3]` {
+		t.Errorf("Expected unknown field to be encoded, got %s", b)
+	}
+	metadata := decoded["metadata"].(map[string]any)
+	if metadata["new_meta"] != "x" || metadata["rookie_year"] != "2018" {
+		t.Errorf("Expected nested unknown field to be encoded, got %v", metadata)
+	}
+
+	// Decoding the output again gives the same unknown fields
+	again, err := DecodePlayer(out)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(again.Unknown) != 2 || string(again.Unknown["metadata.new_meta"]) != `"x"` {
+		t.Errorf("Expected unknown fields to round trip, got %v", again.Unknown)
+	}
+}
+
+func TestModelNestedInCollections(t *testing.T) {
+	// Players in the full list do not keep their raw JSON
+	var players Players
+	if err := json.Unmarshal([]byte(`{"1":{"player_id":"1","extra":true},"2":{"player_id":"2"}}`), &players); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if players["1"].PlayerID != "1" || players["1"].Raw != nil || players["1"].Unknown != nil {
+		t.Errorf("Expected player 1 without raw JSON, got %+v", players["1"])
+	}
+
+	var projections Projections
+	if err := json.Unmarshal([]byte(`[{"player_id":"1","stats":{"pts_ppr":10,"pts_new":3},"player":{"player_id":"1","extra":1}}]`), &projections); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(projections[0].Unknown) != 2 || string(projections[0].Unknown["stats.pts_new"]) != "3" {
+		t.Errorf("Expected unknown stat on projection, got %v", projections[0].Unknown)
+	}
+	if string(projections[0].Unknown["player.extra"]) != "1" {
+		t.Errorf("Expected unknown field on the projection's player, got %v", projections[0].Unknown)
+	}
+}
+
+func TestModelNull(t *testing.T) {
+	league := League{LeagueID: "1"}
+	if err := json.Unmarshal([]byte(`null`), &league); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if league.LeagueID != "1" || league.Raw != nil {
+		t.Errorf("Expected null to leave the league unchanged, got %+v", league)
+	}
+
+	out, err := json.Marshal(Roster{RosterID: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var decoded map[string]any
+	json.Unmarshal(out, &decoded)
+	if decoded["roster_id"] != float64(1) {
+		t.Errorf("Expected roster_id 1, got %v", decoded["roster_id"])
+	}
+}
+
+func TestSmallModelsUnknownFields(t *testing.T) {
+	var transactions []Transaction
+	if err := json.Unmarshal([]byte(`[{"transaction_id":"1","type":"trade","new_field":true}]`), &transactions); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if transactions[0].TransactionID != "1" || string(transactions[0].Unknown["new_field"]) != "true" {
+		t.Errorf("Expected unknown field on transaction, got %+v", transactions[0])
+	}
+
+	var rounds []PlayoffRound
+	if err := json.Unmarshal([]byte(`[{"r":1,"m":2,"t1_from":{"w":1,"x":3}}]`), &rounds); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if rounds[0].T1From.W != 1 || string(rounds[0].Unknown["t1_from.x"]) != "3" {
+		t.Errorf("Expected nested unknown field on playoff round, got %+v", rounds[0])
+	}
+
+	var state SportState
+	data := []byte(`{"week":3,"season_has_scores":true}`)
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if state.Week != 3 || string(state.Raw) != string(data) || string(state.Unknown["season_has_scores"]) != "true" {
+		t.Errorf("Expected raw and unknown fields on sport state, got %+v", state)
+	}
+}
+
+func TestGetPlayerKeepsRaw(t *testing.T) {
+	body := `{"player_id":"4984","full_name":"Josh Allen","brand_new":true}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+	})
+
+	player, err := client.GetPlayer(SportNFL, 4984)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(player.Raw) != body || string(player.Unknown["brand_new"]) != "true" {
+		t.Errorf("Expected raw JSON and unknown fields on the player, got %s %v", player.Raw, player.Unknown)
+	}
+}
+
+func TestDecodePlayersKeepsRaw(t *testing.T) {
+	data := `{"1":{"player_id":"1","extra":true},"2":{"player_id":"2"}}`
+
+	players := make(map[string]Player)
+	err := DecodePlayers(strings.NewReader(data), func(id string, player Player) error {
+		players[id] = player
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(players["1"].Raw) != `{"player_id":"1","extra":true}` || string(players["1"].Unknown["extra"]) != "true" {
+		t.Errorf("Expected raw JSON and unknown fields on player 1, got %s %v", players["1"].Raw, players["1"].Unknown)
+	}
+	if players["2"].Unknown != nil {
+		t.Errorf("Expected no unknown fields on player 2, got %v", players["2"].Unknown)
+	}
+}
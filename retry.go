@@ -0,0 +1,109 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	defaultRetryBaseBackoff time.Duration = 500 * time.Millisecond
+	defaultRetryMaxBackoff  time.Duration = 30 * time.Second
+)
+
+var defaultRetryableStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy controls how failed requests are retried. The zero value disables retries.
+//
+// Every retry waits for the rate limiter again before it is sent, so retries count
+// against the client's rate limit like any other request.
+type RetryPolicy struct {
+	MaxAttempts      int           // Total attempts including the first one, 0 or 1 disables retries
+	BaseBackoff      time.Duration // Backoff before the first retry, doubled on each attempt (default: 500ms)
+	MaxBackoff       time.Duration // Upper bound for the exponential backoff (default: 30s)
+	Jitter           float64       // Fraction of the backoff to randomize, between 0 and 1
+	IgnoreRetryAfter bool          // Do not wait for the Retry-After header sent with the response, otherwise a Retry-After above MaxBackoff is not retried
+	RetryableStatus  []int         // Status codes to retry (default: 429, 500, 502, 503, 504)
+}
+
+// Fill in the defaults for any unset fields.
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.BaseBackoff <= 0 {
+		p.BaseBackoff = defaultRetryBaseBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaultRetryMaxBackoff
+	}
+	if p.MaxBackoff < p.BaseBackoff {
+		p.MaxBackoff = p.BaseBackoff
+	}
+	p.Jitter = min(max(p.Jitter, 0), 1)
+	if len(p.RetryableStatus) == 0 {
+		p.RetryableStatus = defaultRetryableStatus
+	}
+	return p
+}
+
+// Check if the error returned by the given attempt should be retried.
+func (p RetryPolicy) shouldRetry(attempt int, err error) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	// Return the error instead of blocking when the server asks to wait longer than MaxBackoff
+	if !p.IgnoreRetryAfter && apiErr.RetryAfter > p.MaxBackoff {
+		return false
+	}
+
+	return slices.Contains(p.RetryableStatus, apiErr.StatusCode)
+}
+
+// Calculate how long to wait after the given attempt failed with err.
+func (p RetryPolicy) backoff(attempt int, err error) time.Duration {
+	// Stop doubling once the max is reached so a large attempt never overflows
+	d := p.BaseBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+
+	var apiErr *APIError
+	if !p.IgnoreRetryAfter && errors.As(err, &apiErr) && apiErr.RetryAfter > d {
+		d = apiErr.RetryAfter
+	}
+
+	return d
+}
+
+// Wait for the duration or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,222 @@
+package sleeper
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetrySucceedsAfterFailures(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"league_id": "123"}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Retry: RetryPolicy{
+			MaxAttempts: 3,
+			BaseBackoff: time.Millisecond,
+		},
+	})
+
+	league, err := client.GetLeague("123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if league.LeagueID != "123" {
+		t.Errorf("Expected league ID 123, got %s", league.LeagueID)
+	}
+	if n := hits.Load(); n != 3 {
+		t.Errorf("Expected 3 attempts, got %d", n)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	tests := []struct {
+		name         string
+		statusCode   int
+		expectedHits int32
+	}{
+		{"retryable status", http.StatusTooManyRequests, 4},
+		{"not retryable status", http.StatusNotFound, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				hits.Add(1)
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer ts.Close()
+
+			client := NewClientWithOptions(ClientOptions{
+				BaseURL: ts.URL,
+				Retry: RetryPolicy{
+					MaxAttempts: 4,
+					BaseBackoff: time.Millisecond,
+				},
+			})
+
+			_, err := client.GetLeague("123")
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.statusCode {
+				t.Errorf("Expected APIError with status %d, got %v", tt.statusCode, err)
+			}
+			if n := hits.Load(); n != tt.expectedHits {
+				t.Errorf("Expected %d attempts, got %d", tt.expectedHits, n)
+			}
+		})
+	}
+}
+
+func TestRetryDisabledByDefault(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+	})
+
+	if _, err := client.GetLeague("123"); !errors.Is(err, ErrServerError) {
+		t.Errorf("Expected ErrServerError, got %v", err)
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("Expected 1 attempt, got %d", n)
+	}
+}
+
+func TestRetryWaitsForRateLimiter(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL:   ts.URL,
+		RateLimit: 10,
+		Retry: RetryPolicy{
+			MaxAttempts: 3,
+			BaseBackoff: time.Millisecond,
+		},
+	})
+
+	start := time.Now()
+	client.GetLeague("123")
+	elapsed := time.Since(start)
+
+	// Two retries at 10 req/s need at least 200ms of limiter waits
+	if elapsed < 180*time.Millisecond {
+		t.Errorf("Expected retries to wait for the rate limiter, took %v", elapsed)
+	}
+	if n := hits.Load(); n != 3 {
+		t.Errorf("Expected 3 attempts, got %d", n)
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Retry: RetryPolicy{
+			MaxAttempts: 2,
+			BaseBackoff: time.Millisecond,
+		},
+	})
+
+	start := time.Now()
+	if _, err := client.GetLeague("123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected retry to wait for Retry-After, took %v", elapsed)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 10,
+		BaseBackoff: 100 * time.Millisecond,
+		MaxBackoff:  time.Second,
+	}.withDefaults()
+
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for i, want := range expected {
+		if got := policy.backoff(i+1, nil); got != want {
+			t.Errorf("backoff(%d) = %v, expected %v", i+1, got, want)
+		}
+	}
+
+	// Large attempts stay at the max instead of overflowing
+	for _, attempt := range []int{40, 64, 100, 1000} {
+		if got := policy.backoff(attempt, nil); got != time.Second {
+			t.Errorf("backoff(%d) = %v, expected %v", attempt, got, time.Second)
+		}
+	}
+
+	// Jitter only ever shortens the backoff
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		got := policy.backoff(2, nil)
+		if got < 100*time.Millisecond || got > 200*time.Millisecond {
+			t.Fatalf("backoff with jitter = %v, expected between 100ms and 200ms", got)
+		}
+	}
+
+	// Retry-After overrides a shorter backoff unless ignored
+	err := &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 800 * time.Millisecond}
+	if got := policy.backoff(1, err); got != 800*time.Millisecond {
+		t.Errorf("Expected Retry-After of 800ms, got %v", got)
+	}
+
+	// A Retry-After longer than the max backoff is returned instead of waited for
+	if !policy.shouldRetry(1, err) {
+		t.Error("Expected Retry-After within the max backoff to be retried")
+	}
+	long := &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Hour}
+	if policy.shouldRetry(1, long) {
+		t.Error("Expected Retry-After above the max backoff not to be retried")
+	}
+
+	policy.IgnoreRetryAfter = true
+	if !policy.shouldRetry(1, long) {
+		t.Error("Expected ignored Retry-After to be retried")
+	}
+	if got := policy.backoff(1, err); got > 100*time.Millisecond {
+		t.Errorf("Expected Retry-After to be ignored, got %v", got)
+	}
+}
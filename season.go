@@ -0,0 +1,374 @@
+package sleeper
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"math/bits"
+	"slices"
+	"sync"
+)
+
+// SeasonOptions control which weeks GetSeasonSchedule fetches and how many are fetched at once.
+type SeasonOptions struct {
+	LastWeek    int // Last week fetched, defaults to the last week of the playoffs
+	Concurrency int // Weeks fetched at once, defaults to DefaultBatchConcurrency
+}
+
+// SeasonGame is one game in a week. Results are empty until either team has scored.
+type SeasonGame struct {
+	MatchupID int // Matchup ID for the week, zero for playoff games paired from the bracket
+	RosterID1 int
+	RosterID2 int
+	Points1   float32
+	Points2   float32
+	Result1   MatchResult
+	Result2   MatchResult
+
+	BracketMatch  int  // Match ID in the playoff bracket, zero for regular season games
+	LosersBracket bool // The game is in the losers bracket
+}
+
+// Played reports whether either team has scored.
+func (g SeasonGame) Played() bool {
+	return g.Result1 != ""
+}
+
+// Winner returns the roster ID of the winner, zero for a tie or a game that has not been played.
+func (g SeasonGame) Winner() int {
+	switch {
+	case g.Result1 == ResultWin:
+		return g.RosterID1
+	case g.Result2 == ResultWin:
+		return g.RosterID2
+	default:
+		return 0
+	}
+}
+
+// SeasonWeek holds the games for one week.
+type SeasonWeek struct {
+	Week    int
+	Playoff bool         // The week is on or after League.Settings.PlayoffWeekStart
+	Games   []SeasonGame // Ordered by matchup ID, then bracket match
+	Byes    []int        // Roster IDs without a game this week
+}
+
+// SeasonSchedule holds the games for every week of a league's season.
+type SeasonSchedule struct {
+	LeagueID string
+	Weeks    []SeasonWeek
+}
+
+// Week returns the games for the week.
+func (s SeasonSchedule) Week(week int) (SeasonWeek, bool) {
+	for _, w := range s.Weeks {
+		if w.Week == week {
+			return w, true
+		}
+	}
+	return SeasonWeek{}, false
+}
+
+// Games returns the games the roster played in, in week order.
+func (s SeasonSchedule) Games(roster_id int) []SeasonGame {
+	var games []SeasonGame
+	for _, w := range s.Weeks {
+		for _, g := range w.Games {
+			if g.RosterID1 == roster_id || g.RosterID2 == roster_id {
+				games = append(games, g)
+			}
+		}
+	}
+	return games
+}
+
+// Get the matchups for every week of the season, fetching weeks concurrently, and pair the
+// rosters into games. Requests still share the client's rate limiter. Playoff weeks where
+// Sleeper does not set matchup IDs are paired using the winners and losers brackets.
+// (GET `https://api.sleeper.app/v1/league/<league_id>/matchups/<week>` for each week)
+func (c *Client) GetSeasonSchedule(league_id string, opts SeasonOptions) (SeasonSchedule, error) {
+	return c.GetSeasonScheduleContext(context.Background(), league_id, opts)
+}
+
+// GetSeasonScheduleContext is like GetSeasonSchedule but accepts a context.
+func (c *Client) GetSeasonScheduleContext(ctx context.Context, league_id string, opts SeasonOptions) (SeasonSchedule, error) {
+	return FetchSeasonSchedule(ctx, c, league_id, opts)
+}
+
+// FetchSeasonSchedule gets the league and the matchups for every week from the api,
+// and the brackets when a playoff week needs them, then pairs the games with BuildSeasonSchedule.
+func FetchSeasonSchedule(ctx context.Context, api LeagueAPI, league_id string, opts SeasonOptions) (SeasonSchedule, error) {
+	league, err := api.GetLeagueContext(ctx, league_id)
+	if err != nil {
+		return SeasonSchedule{}, err
+	}
+
+	lastWeek := opts.LastWeek
+	if lastWeek <= 0 {
+		lastWeek = seasonLastWeek(league)
+	}
+
+	weeks, err := getSeasonMatchups(ctx, api, league_id, lastWeek, opts.Concurrency)
+	if err != nil {
+		return SeasonSchedule{}, err
+	}
+
+	// Only get the brackets when a playoff week has teams without a matchup ID
+	var winners, losers []PlayoffRound
+	if needsBrackets(league, weeks) {
+		winners, err = api.GetPlayoffsWinnersBracketContext(ctx, league_id)
+		if err != nil {
+			return SeasonSchedule{}, err
+		}
+
+		losers, err = api.GetPlayoffsLosersBracketContext(ctx, league_id)
+		if err != nil {
+			return SeasonSchedule{}, err
+		}
+	}
+
+	schedule := BuildSeasonSchedule(league, weeks, winners, losers)
+
+	clientOf(api).debug(ctx, "sleeper: built season schedule",
+		slog.String("league_id", league_id),
+		slog.Int("weeks", len(schedule.Weeks)),
+		slog.Bool("brackets", winners != nil || losers != nil),
+	)
+
+	return schedule, nil
+}
+
+// BuildSeasonSchedule pairs the matchups for each week into games without sending any
+// requests. Rosters are paired by matchup ID. In playoff weeks, rosters without a matchup ID
+// are paired using the winners and losers brackets, which may be nil.
+func BuildSeasonSchedule(league League, weeks map[int][]Matchup, winners, losers []PlayoffRound) SeasonSchedule {
+	schedule := SeasonSchedule{LeagueID: league.LeagueID}
+
+	for _, week := range slices.Sorted(maps.Keys(weeks)) {
+		sw := SeasonWeek{
+			Week:    week,
+			Playoff: league.Settings.PlayoffWeekStart > 0 && week >= league.Settings.PlayoffWeekStart,
+		}
+
+		games, unpaired := groupMatchups(weeks[week])
+		for _, game := range games {
+			sw.Games = append(sw.Games, newSeasonGame(game[0], game[1]))
+		}
+
+		if sw.Playoff && len(unpaired) > 0 {
+			round := playoffRound(league, week)
+			var bracketGames []SeasonGame
+			bracketGames, unpaired = pairBracket(unpaired, winners, round, false)
+			sw.Games = append(sw.Games, bracketGames...)
+			bracketGames, unpaired = pairBracket(unpaired, losers, round, true)
+			sw.Games = append(sw.Games, bracketGames...)
+		}
+
+		for _, m := range unpaired {
+			sw.Byes = append(sw.Byes, m.RosterID)
+		}
+		slices.Sort(sw.Byes)
+
+		schedule.Weeks = append(schedule.Weeks, sw)
+	}
+
+	return schedule
+}
+
+// Fetch the matchups for weeks 1 through lastWeek using a bounded number of workers.
+func getSeasonMatchups(ctx context.Context, api LeagueAPI, league_id string, lastWeek int, concurrency int) (map[int][]Matchup, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	results := make([][]Matchup, lastWeek)
+	errs := make([]error, lastWeek)
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(concurrency, lastWeek) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				// Skip weeks that have not started once the context is cancelled
+				if err := ctx.Err(); err != nil {
+					errs[i] = fmt.Errorf("week %d: %w", i+1, err)
+					continue
+				}
+
+				matchups, err := api.GetMatchupsContext(ctx, league_id, i+1)
+				if err != nil {
+					errs[i] = fmt.Errorf("week %d: %w", i+1, err)
+				}
+				results[i] = matchups
+			}
+		}()
+	}
+
+	for i := range lastWeek {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	weeks := make(map[int][]Matchup, lastWeek)
+	for i, matchups := range results {
+		weeks[i+1] = matchups
+	}
+
+	return weeks, nil
+}
+
+// Get the last week of the playoffs, or the last regular season week when the league has no playoff week start.
+func seasonLastWeek(league League) int {
+	start := league.Settings.PlayoffWeekStart
+	if start <= 0 {
+		return DefaultRegularSeasonWeeks
+	}
+
+	rounds := playoffRounds(league.Settings.PlayoffTeams)
+	switch league.Settings.PlayoffRoundType {
+	case 1: // Two week championship
+		return start + rounds
+	case 2: // Two weeks for every round
+		return start + rounds*2 - 1
+	default:
+		return start + rounds - 1
+	}
+}
+
+// Get the number of rounds needed for the playoff teams, at least one.
+func playoffRounds(teams int) int {
+	if teams <= 2 {
+		return 1
+	}
+	return bits.Len(uint(teams - 1))
+}
+
+// Get the playoff round played in the week.
+func playoffRound(league League, week int) int {
+	offset := week - league.Settings.PlayoffWeekStart
+	switch league.Settings.PlayoffRoundType {
+	case 1: // Two week championship
+		return min(offset+1, playoffRounds(league.Settings.PlayoffTeams))
+	case 2: // Two weeks for every round
+		return offset/2 + 1
+	default:
+		return offset + 1
+	}
+}
+
+// Check if any playoff week has teams that can only be paired using the brackets.
+func needsBrackets(league League, weeks map[int][]Matchup) bool {
+	if league.Settings.PlayoffWeekStart <= 0 {
+		return false
+	}
+
+	for week, matchups := range weeks {
+		if week < league.Settings.PlayoffWeekStart {
+			continue
+		}
+		if _, unpaired := groupMatchups(matchups); len(unpaired) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Group the matchups for a week into games by matchup ID, ordered by matchup ID. Teams
+// without a matchup ID or an opponent are returned as unpaired.
+func groupMatchups(matchups []Matchup) ([][2]Matchup, []Matchup) {
+	byID := make(map[int][]Matchup)
+	var unpaired []Matchup
+	for _, m := range matchups {
+		if m.MatchupID == 0 {
+			unpaired = append(unpaired, m)
+			continue
+		}
+		byID[m.MatchupID] = append(byID[m.MatchupID], m)
+	}
+
+	var games [][2]Matchup
+	for _, id := range slices.Sorted(maps.Keys(byID)) {
+		teams := byID[id]
+		if len(teams) != 2 {
+			unpaired = append(unpaired, teams...)
+			continue
+		}
+		games = append(games, [2]Matchup{teams[0], teams[1]})
+	}
+
+	slices.SortFunc(unpaired, func(a, b Matchup) int { return cmp.Compare(a.RosterID, b.RosterID) })
+
+	return games, unpaired
+}
+
+// Create a game from the matchups of both teams.
+func newSeasonGame(a, b Matchup) SeasonGame {
+	game := SeasonGame{
+		MatchupID: a.MatchupID,
+		RosterID1: a.RosterID,
+		RosterID2: b.RosterID,
+		Points1:   matchupPoints(a),
+		Points2:   matchupPoints(b),
+	}
+
+	if game.Points1 != 0 || game.Points2 != 0 {
+		game.Result1 = matchResult(game.Points1, game.Points2)
+		game.Result2 = matchResult(game.Points2, game.Points1)
+	}
+
+	return game
+}
+
+// Pair unpaired teams using the bracket matches for the round. Teams not found in the
+// bracket are returned as still unpaired.
+func pairBracket(unpaired []Matchup, bracket []PlayoffRound, round int, losers bool) ([]SeasonGame, []Matchup) {
+	byRoster := make(map[int]Matchup, len(unpaired))
+	for _, m := range unpaired {
+		byRoster[m.RosterID] = m
+	}
+
+	matches := slices.Clone(bracket)
+	slices.SortFunc(matches, func(a, b PlayoffRound) int { return cmp.Compare(a.M, b.M) })
+
+	var games []SeasonGame
+	for _, match := range matches {
+		if match.R != round {
+			continue
+		}
+
+		a, okA := byRoster[match.T1]
+		b, okB := byRoster[match.T2]
+		if !okA || !okB {
+			continue
+		}
+
+		game := newSeasonGame(a, b)
+		game.BracketMatch = match.M
+		game.LosersBracket = losers
+		games = append(games, game)
+
+		delete(byRoster, match.T1)
+		delete(byRoster, match.T2)
+	}
+
+	var rest []Matchup
+	for _, m := range unpaired {
+		if _, ok := byRoster[m.RosterID]; ok {
+			rest = append(rest, m)
+		}
+	}
+
+	return games, rest
+}
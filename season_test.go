@@ -0,0 +1,97 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFetchSeasonSchedule(t *testing.T) {
+	f := newStandingsFake()
+	f.matchups[1] = append(f.matchups[1], Matchup{RosterID: 5, Points: 75})
+	f.matchups[5] = []Matchup{
+		{RosterID: 1, Points: 110},
+		{RosterID: 2, Points: 105},
+		{RosterID: 3, Points: 90},
+		{RosterID: 4, Points: 95},
+	}
+	f.winners = []PlayoffRound{{R: 1, M: 1, T1: 1, T2: 2, W: 1, L: 2}}
+	f.losers = []PlayoffRound{{R: 1, M: 1, T1: 3, T2: 4, W: 4, L: 3}}
+
+	schedule, err := FetchSeasonSchedule(context.Background(), f, "1", SeasonOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(schedule.Weeks) != 5 {
+		t.Fatalf("Expected 5 weeks, got %d", len(schedule.Weeks))
+	}
+
+	week1, _ := schedule.Week(1)
+	if week1.Playoff || len(week1.Games) != 2 || len(week1.Byes) != 1 || week1.Byes[0] != 5 {
+		t.Errorf("Expected 2 games and a bye for roster 5 in week 1, got %+v", week1)
+	}
+
+	want := SeasonGame{MatchupID: 1, RosterID1: 1, RosterID2: 2, Points1: 100, Points2: 90, Result1: ResultWin, Result2: ResultLoss}
+	if week1.Games[0] != want {
+		t.Errorf("Expected %+v, got %+v", want, week1.Games[0])
+	}
+	if week1.Games[1].Result1 != ResultTie || week1.Games[1].Winner() != 0 {
+		t.Errorf("Expected a tie, got %+v", week1.Games[1])
+	}
+
+	week4, _ := schedule.Week(4)
+	if len(week4.Games) != 1 || week4.Games[0].Played() {
+		t.Errorf("Expected an unplayed game in week 4, got %+v", week4.Games)
+	}
+
+	// Playoff teams have no matchup ID and are paired from the brackets
+	week5, _ := schedule.Week(5)
+	if !week5.Playoff || len(week5.Games) != 2 || len(week5.Byes) != 0 {
+		t.Fatalf("Expected 2 playoff games in week 5, got %+v", week5)
+	}
+	final := week5.Games[0]
+	if final.BracketMatch != 1 || final.LosersBracket || final.Winner() != 1 {
+		t.Errorf("Expected roster 1 to win the final, got %+v", final)
+	}
+	if consolation := week5.Games[1]; !consolation.LosersBracket || consolation.Winner() != 4 {
+		t.Errorf("Expected roster 4 to win the losers bracket game, got %+v", consolation)
+	}
+
+	if games := schedule.Games(3); len(games) != 4 {
+		t.Errorf("Expected 4 games for roster 3, got %d", len(games))
+	}
+}
+
+func TestFetchSeasonScheduleError(t *testing.T) {
+	f := newStandingsFake()
+	f.err = ErrNotFound
+
+	if _, err := FetchSeasonSchedule(context.Background(), f, "1", SeasonOptions{}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestSeasonLastWeek(t *testing.T) {
+	tests := []struct {
+		start, teams, roundType int
+		want                    int
+	}{
+		{0, 0, 0, DefaultRegularSeasonWeeks},
+		{15, 6, 0, 17},
+		{15, 4, 1, 17},
+		{15, 4, 2, 18},
+		{15, 8, 0, 17},
+	}
+
+	for _, tt := range tests {
+		league := League{}
+		league.Settings.PlayoffWeekStart = tt.start
+		league.Settings.PlayoffTeams = tt.teams
+		league.Settings.PlayoffRoundType = tt.roundType
+
+		if got := seasonLastWeek(league); got != tt.want {
+			t.Errorf("Expected last week %d for %+v, got %d", tt.want, tt, got)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package sleeper
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// Coalesces concurrent requests for the same URL into a single HTTP request.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	data    []byte
+	err     error
+	event   RequestEvent
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{calls: make(map[string]*flightCall)}
+}
+
+// Run fn once for all concurrent callers with the same key. The shared request is
+// only cancelled once every caller's context is done. The returned event is the one
+// filled in by fn, and the returned bool is true when the result came from another
+// caller's request.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context, event *RequestEvent) ([]byte, error)) ([]byte, RequestEvent, bool, error) {
+	g.mu.Lock()
+	call, shared := g.calls[key]
+	if shared {
+		call.waiters++
+	} else {
+		sharedCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &flightCall{
+			done:    make(chan struct{}),
+			cancel:  cancel,
+			waiters: 1,
+		}
+		g.calls[key] = call
+
+		go func() {
+			defer cancel()
+			call.event.URL = key
+			call.data, call.err = fn(sharedCtx, &call.event)
+
+			// The call may already be replaced if every caller gave up
+			g.mu.Lock()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+
+			close(call.done)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		if shared {
+			return slices.Clone(call.data), call.event, true, call.err
+		}
+		return call.data, call.event, false, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// Remove the cancelled call so new callers start a fresh request
+			call.cancel()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, RequestEvent{}, shared, ctx.Err()
+	}
+}
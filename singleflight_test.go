@@ -0,0 +1,208 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrentRequestsAreCoalesced(t *testing.T) {
+	var hits atomic.Int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		<-release
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"week": 3}`))
+	}))
+	defer ts.Close()
+
+	var shared atomic.Int32
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Observer: ObserverFunc(func(event RequestEvent) {
+			if event.Shared {
+				shared.Add(1)
+			}
+		}),
+	})
+
+	const callers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			state, err := client.GetSportState("nfl")
+			if err == nil && state.Week != 3 {
+				err = errors.New("unexpected week")
+			}
+			errs <- err
+		}()
+	}
+
+	// Wait for the first request to reach the server before releasing it
+	for hits.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("Expected 1 upstream request, got %d", n)
+	}
+	if n := shared.Load(); n != callers-1 {
+		t.Errorf("Expected %d shared responses, got %d", callers-1, n)
+	}
+}
+
+func TestCoalescingDisabled(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL:           ts.URL,
+		RateLimit:         1000,
+		DisableCoalescing: true,
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.GetSportState("nfl")
+		}()
+	}
+	wg.Wait()
+
+	if n := hits.Load(); n != 3 {
+		t.Errorf("Expected 3 upstream requests, got %d", n)
+	}
+}
+
+func TestCoalescedRequestCancellation(t *testing.T) {
+	var cancelled atomic.Bool
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		select {
+		case <-release:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"week": 3}`))
+		case <-r.Context().Done():
+			cancelled.Store(true)
+		}
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+	})
+
+	// The first caller gives up but the second one still gets the response
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	result1 := make(chan error, 1)
+	go func() {
+		_, err := client.GetSportStateContext(ctx1, "nfl")
+		result1 <- err
+	}()
+	<-started
+
+	result2 := make(chan error, 1)
+	go func() {
+		_, err := client.GetSportStateContext(context.Background(), "nfl")
+		result2 <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancel1()
+	if err := <-result1; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	release <- struct{}{}
+	if err := <-result2; err != nil {
+		t.Errorf("Expected no error for remaining caller, got %v", err)
+	}
+	if cancelled.Load() {
+		t.Error("Expected shared request to continue while a caller is waiting")
+	}
+
+	// When every caller gives up the shared request is cancelled
+	ctx3, cancel3 := context.WithCancel(context.Background())
+	result3 := make(chan error, 1)
+	go func() {
+		_, err := client.GetSportStateContext(ctx3, "nfl")
+		result3 <- err
+	}()
+	<-started
+	cancel3()
+	if err := <-result3; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cancelled.Load() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if !cancelled.Load() {
+		t.Error("Expected shared request to be cancelled")
+	}
+}
+
+func TestCoalescedRequestAfterAllCallersCancel(t *testing.T) {
+	g := newFlightGroup()
+
+	// The first request keeps running for a while after it is cancelled
+	hold := make(chan struct{})
+	started := make(chan struct{})
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	result1 := make(chan error, 1)
+	go func() {
+		_, _, _, err := g.do(ctx1, "key", func(ctx context.Context, event *RequestEvent) ([]byte, error) {
+			close(started)
+			<-ctx.Done()
+			<-hold
+			return nil, ctx.Err()
+		})
+		result1 <- err
+	}()
+	<-started
+
+	cancel1()
+	if err := <-result1; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	// A new caller must not join the cancelled request
+	data, _, shared, err := g.do(context.Background(), "key", func(ctx context.Context, event *RequestEvent) ([]byte, error) {
+		return []byte("fresh"), ctx.Err()
+	})
+	if err != nil || shared || string(data) != "fresh" {
+		t.Errorf("Expected a fresh request, got %q (shared %v, error %v)", data, shared, err)
+	}
+
+	close(hold)
+}
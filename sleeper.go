@@ -8,9 +8,8 @@ package sleeper
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -36,13 +35,45 @@ type Client struct {
 	httpClient *http.Client
 	sleeperURL string
 	limiter    *rate.Limiter
+	retry      RetryPolicy
+	middleware []Middleware
+	cache      Cache
+	cacheTTLs  map[string]time.Duration
+	observer   Observer
+	logger     *slog.Logger
+	redactLogs bool
+	flights    *flightGroup
+	drift      *DriftReport
+	offline    bool
+
+	snapshotDir string
 }
 
 // ClientOption is a function that modifies a Client.
 type ClientOptions struct {
-	BaseURL   string
-	Timeout   time.Duration
-	RateLimit float64 // Rate limit in requests per second
+	BaseURL    string
+	Timeout    time.Duration
+	RateLimit  float64      // Rate limit in requests per second
+	Retry      RetryPolicy  // Retry policy for rate limited and failed requests
+	HTTPClient *http.Client // HTTP client used to send requests, copied so Timeout does not modify it
+	Middleware []Middleware // Middleware applied to every request, the first one is the outermost
+
+	Cache    Cache                    // Cache for responses, nil disables caching
+	CacheTTL map[string]time.Duration // Overrides DefaultCacheTTLs by endpoint template, zero disables caching for the endpoint
+
+	Cassette *Cassette // Records or replays every request, applied after all other middleware
+	Observer Observer  // Receives an event after every request
+
+	Logger     *slog.Logger // Logger for debug records about requests, nil disables logging
+	RedactLogs bool         // Log endpoint templates instead of URLs and hide user and team names
+
+	DisableCoalescing bool // Send every request even when an identical one is in flight
+
+	StrictDecoding bool // Report unknown fields and type mismatches in responses, see Client.DriftReport
+
+	Offline bool // Serve every request from Cache, including expired responses, and never use the network. Requires Cache or PlayerSnapshotDir
+
+	PlayerSnapshotDir string // Directory of a PlayerSnapshotStore that offline mode reads players from when they are not in Cache
 }
 
 // Create a new Sleeper Client.
@@ -53,6 +84,7 @@ func NewClient() Client {
 		},
 		sleeperURL: sleeperBaseURL,
 		limiter:    rate.NewLimiter(rate.Limit(1000/60), 1), // Default: 1000 req/min, burst 1
+		flights:    newFlightGroup(),
 	}
 	return client
 }
@@ -65,6 +97,13 @@ func NewClientWithOptions(opts ClientOptions) Client {
 		},
 		sleeperURL: sleeperBaseURL,
 		limiter:    rate.NewLimiter(rate.Limit(1000/60), 1), // Default: 1000 req/min, burst 1
+		flights:    newFlightGroup(),
+	}
+
+	// Use a copy of the provided HTTP client
+	if opts.HTTPClient != nil {
+		httpClient := *opts.HTTPClient
+		client.httpClient = &httpClient
 	}
 
 	// Set the timeout for the HTTP client
@@ -82,6 +121,46 @@ func NewClientWithOptions(opts ClientOptions) Client {
 		client.limiter = rate.NewLimiter(rate.Limit(opts.RateLimit), 1)
 	}
 
+	// Set the retry policy for failed requests
+	if opts.Retry.MaxAttempts > 1 {
+		client.retry = opts.Retry.withDefaults()
+	}
+
+	// Set the middleware for each request
+	client.middleware = append(client.middleware, opts.Middleware...)
+
+	// Record or replay requests closest to the network
+	if opts.Cassette != nil {
+		client.middleware = append(client.middleware, opts.Cassette.Middleware())
+	}
+
+	// Set the cache for responses
+	if opts.Cache != nil {
+		client.cache = opts.Cache
+		client.cacheTTLs = opts.CacheTTL
+	}
+
+	// Set the observer for request events
+	client.observer = opts.Observer
+
+	// Set the logger for debug records
+	client.logger = opts.Logger
+	client.redactLogs = opts.RedactLogs
+
+	// Send every request when coalescing is disabled
+	if opts.DisableCoalescing {
+		client.flights = nil
+	}
+
+	// Collect differences between responses and models in strict mode
+	if opts.StrictDecoding {
+		client.drift = newDriftReport()
+	}
+
+	// Only serve saved responses in offline mode
+	client.offline = opts.Offline
+	client.snapshotDir = opts.PlayerSnapshotDir
+
 	return client
 }
 
@@ -92,27 +171,216 @@ func (c *Client) BaseURL() string {
 
 // Send a basic HTTP GET request.
 func (c *Client) getRequest(url string) ([]byte, error) {
-	// Wait for rate limiter
-	if err := c.limiter.Wait(context.Background()); err != nil {
+	return c.getRequestContext(context.Background(), url)
+}
+
+// Send a basic HTTP GET request, using the cache when enabled and retrying according
+// to the client's retry policy. The context bounds both the wait for the rate limiter
+// and the HTTP request itself.
+func (c *Client) getRequestContext(ctx context.Context, url string) ([]byte, error) {
+	event := RequestEvent{URL: url}
+	start := time.Now()
+
+	data, err := c.getRequestCached(ctx, url, &event)
+	c.finishRequest(ctx, &event, start, len(data), err)
+
+	return data, err
+}
+
+// Send a basic HTTP GET request and return the response body without reading it.
+// Streamed responses are not cached or shared with concurrent requests, so a stream
+// always gets the latest response. The request is logged and observed when the body
+// is closed.
+func (c *Client) getStreamContext(ctx context.Context, url string) (io.ReadCloser, error) {
+	if c.offline {
+		return c.getOfflineStream(ctx, url)
+	}
+
+	event := RequestEvent{URL: url}
+	start := time.Now()
+
+	resp, err := c.openWithRetry(ctx, url, &event)
+	if err != nil {
+		c.finishRequest(ctx, &event, start, 0, err)
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Get(url)
+	return &streamBody{
+		ReadCloser: resp.Body,
+		finish: func(bytes int, err error) {
+			c.finishRequest(ctx, &event, start, bytes, err)
+		},
+	}, nil
+}
+
+// Response body that reports the request as finished when it is closed.
+type streamBody struct {
+	io.ReadCloser
+	bytes  int
+	err    error
+	closed bool
+	finish func(bytes int, err error)
+}
+
+func (b *streamBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.bytes += n
+	if err != nil && err != io.EOF {
+		b.err = err
+	}
+	return n, err
+}
+
+func (b *streamBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.closed {
+		b.closed = true
+		b.finish(b.bytes, b.err)
+	}
+	return err
+}
+
+// Log the completed request and send the event to the observer.
+func (c *Client) finishRequest(ctx context.Context, event *RequestEvent, start time.Time, bytes int, err error) {
+	c.debug(ctx, "sleeper: request",
+		c.urlAttr(event.URL),
+		slog.Int("status", event.StatusCode),
+		slog.Int("attempts", event.Attempts),
+		slog.Bool("cached", event.Cached),
+		slog.Duration("duration", time.Since(start)),
+		slog.Int("bytes", bytes),
+		slog.Any("error", err),
+	)
+
+	if c.observer != nil {
+		event.Endpoint = c.endpoint(event.URL)
+		event.Duration = time.Since(start)
+		event.Bytes = bytes
+		event.Err = err
+		c.observer.ObserveRequest(*event)
+	}
+}
+
+// Get the response from the cache or send the request and cache the response.
+func (c *Client) getRequestCached(ctx context.Context, url string, event *RequestEvent) ([]byte, error) {
+	if c.offline {
+		return c.getOffline(url, event)
+	}
+
+	// Check the cache before using a rate limiter token
+	ttl := time.Duration(0)
+	if c.cache != nil {
+		if data, ok := c.cache.Get(url); ok {
+			event.Cached = true
+			event.StatusCode = http.StatusOK
+			return data, nil
+		}
+		ttl = c.cacheTTL(url)
+	}
+
+	data, err := c.getRequestShared(ctx, url, event)
+	if err == nil && ttl > 0 {
+		c.cache.Set(url, data, ttl)
+	}
+
+	return data, err
+}
+
+// Send the request, sharing the response with concurrent requests for the same URL.
+func (c *Client) getRequestShared(ctx context.Context, url string, event *RequestEvent) ([]byte, error) {
+	if c.flights == nil {
+		return c.getRequestWithRetry(ctx, url, event)
+	}
+
+	data, leader, shared, err := c.flights.do(ctx, url, func(ctx context.Context, event *RequestEvent) ([]byte, error) {
+		return c.getRequestWithRetry(ctx, url, event)
+	})
+
+	if shared {
+		event.Shared = true
+		if err == nil {
+			event.StatusCode = http.StatusOK
+		}
+		return data, err
+	}
+
+	event.StatusCode = leader.StatusCode
+	event.Attempts = leader.Attempts
+	event.LimiterWait = leader.LimiterWait
+	return data, err
+}
+
+// Send the request, retrying according to the client's retry policy, and read the response.
+func (c *Client) getRequestWithRetry(ctx context.Context, url string, event *RequestEvent) ([]byte, error) {
+	resp, err := c.openWithRetry(ctx, url, event)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	return io.ReadAll(resp.Body)
+}
+
+// Send the request, retrying according to the client's retry policy. The caller must
+// close the body of the returned response.
+func (c *Client) openWithRetry(ctx context.Context, url string, event *RequestEvent) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		event.Attempts = attempt
+
+		resp, err := c.openRequest(ctx, url, event)
+		if err == nil || !c.retry.shouldRetry(attempt, err) {
+			return resp, err
+		}
+
+		backoff := c.retry.backoff(attempt, err)
+		c.debug(ctx, "sleeper: retrying request",
+			c.urlAttr(url),
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", backoff),
+			slog.Any("error", err),
+		)
+
+		if err := sleepContext(ctx, backoff); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Send a single HTTP GET request after waiting for the rate limiter. Non-200 responses
+// are returned as an *APIError, otherwise the caller must close the response body.
+func (c *Client) openRequest(ctx context.Context, url string, event *RequestEvent) (*http.Response, error) {
+	// Wait for rate limiter
+	waitStart := time.Now()
+	err := c.limiter.Wait(ctx)
+	wait := time.Since(waitStart)
+	event.LimiterWait += wait
+	if err != nil {
+		c.debug(ctx, "sleeper: rate limiter wait cancelled", c.urlAttr(url), slog.Duration("wait", wait), slog.Any("error", err))
+		return nil, err
+	}
+	c.debug(ctx, "sleeper: rate limiter wait", c.urlAttr(url), slog.Duration("wait", wait))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	event.StatusCode = resp.StatusCode
 	if resp.StatusCode != 200 {
-		val, ok := errorCodes[resp.StatusCode]
-		if ok {
-			e := fmt.Sprintf("web request error: %d %s\n", resp.StatusCode, val)
-			return nil, errors.New(e)
-		} else {
-			e := fmt.Sprintf("web request error: %d\n", resp.StatusCode)
-			return nil, errors.New(e)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			URL:        url,
+			Body:       string(body),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
 		}
 	}
 
-	return io.ReadAll(resp.Body)
+	return resp, nil
 }
@@ -2,8 +2,10 @@ package sleeper
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -142,3 +144,62 @@ func TestGetRequestError(t *testing.T) {
 		t.Error("Expected error, got nil")
 	}
 }
+
+func TestGetRequestContextCancelledInLimiter(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	// One request every 10 minutes so the second call blocks in the limiter
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL:   ts.URL,
+		RateLimit: 1.0 / 600,
+	})
+
+	if _, err := client.getRequestContext(context.Background(), ts.URL+"/test"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := client.getRequestContext(ctx, ts.URL+"/test")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected cancelled request to return promptly, took %v", elapsed)
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("Expected 1 upstream hit, got %d", n)
+	}
+}
+
+func TestGetLeagueContextDeadline(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetLeagueContext(ctx, "123")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package sleepertest
+
+import (
+	"cmp"
+	"slices"
+
+	sleeper "github.com/lum8rjack/sleeper-go"
+)
+
+// Model is the in-memory data served by a Server. Use Server.Update to change it
+// while the server is running.
+type Model struct {
+	Users       []sleeper.User
+	Leagues     map[string]*League
+	Drafts      map[string]*Draft
+	SportStates map[sleeper.Sport]sleeper.SportState
+	Players     map[sleeper.Sport]sleeper.Players
+	Trending    map[string][]sleeper.TrendingPlayer // Keyed by "<sport>/<add or drop>"
+	Projections map[WeekKey]sleeper.Projections
+	Schedules   map[SeasonKey]sleeper.Schedule
+	Research    map[WeekKey]map[string]sleeper.PlayerResearch
+	SeasonStats map[PlayerSeasonKey]sleeper.PlayerStats
+	DepthCharts map[string]sleeper.TeamDepthChart // Keyed by NFL team
+	Avatars     map[string][]byte                 // Keyed by avatar ID
+}
+
+// League holds a league and everything returned by the league endpoints.
+type League struct {
+	League         sleeper.League
+	Users          []sleeper.LeagueUser
+	Rosters        []sleeper.Roster
+	Matchups       map[int][]sleeper.Matchup     // Keyed by week
+	Transactions   map[int][]sleeper.Transaction // Keyed by round
+	TradedPicks    []sleeper.TradedPick
+	WinnersBracket []sleeper.PlayoffRound
+	LosersBracket  []sleeper.PlayoffRound
+}
+
+// Draft holds a draft and its picks.
+type Draft struct {
+	Draft       sleeper.Draft
+	Picks       []sleeper.DraftPlayer
+	TradedPicks []sleeper.TradedPick
+}
+
+// SeasonKey identifies a regular season or postseason of a sport.
+type SeasonKey struct {
+	Sport      sleeper.Sport
+	Season     int
+	Postseason bool
+}
+
+// WeekKey identifies a week of a regular season or postseason of a sport.
+type WeekKey struct {
+	Sport      sleeper.Sport
+	Season     int
+	Week       int
+	Postseason bool
+}
+
+// PlayerSeasonKey identifies a player's regular season or postseason of a sport.
+type PlayerSeasonKey struct {
+	Sport      sleeper.Sport
+	PlayerID   string
+	Season     int
+	Postseason bool
+}
+
+// Create an empty model with all maps initialized.
+func newModel() *Model {
+	return &Model{
+		Leagues:     make(map[string]*League),
+		Drafts:      make(map[string]*Draft),
+		SportStates: make(map[sleeper.Sport]sleeper.SportState),
+		Players:     make(map[sleeper.Sport]sleeper.Players),
+		Trending:    make(map[string][]sleeper.TrendingPlayer),
+		Projections: make(map[WeekKey]sleeper.Projections),
+		Schedules:   make(map[SeasonKey]sleeper.Schedule),
+		Research:    make(map[WeekKey]map[string]sleeper.PlayerResearch),
+		SeasonStats: make(map[PlayerSeasonKey]sleeper.PlayerStats),
+		DepthCharts: make(map[string]sleeper.TeamDepthChart),
+		Avatars:     make(map[string][]byte),
+	}
+}
+
+// Find a user by user ID or username.
+func (m *Model) user(user string) (sleeper.User, bool) {
+	for _, u := range m.Users {
+		if u.UserID == user || u.Username == user {
+			return u, true
+		}
+	}
+	return sleeper.User{}, false
+}
+
+// Get the leagues the user is a member of for the sport and season.
+func (m *Model) userLeagues(userID string, sport sleeper.Sport, season string) []sleeper.League {
+	leagues := []sleeper.League{}
+	for _, l := range m.Leagues {
+		if l.League.Sport != sport || l.League.Season != season {
+			continue
+		}
+		for _, u := range l.Users {
+			if u.UserID == userID {
+				leagues = append(leagues, l.League)
+				break
+			}
+		}
+	}
+	slices.SortFunc(leagues, func(a, b sleeper.League) int { return cmp.Compare(a.LeagueID, b.LeagueID) })
+	return leagues
+}
+
+// Get the drafts of the leagues the user is a member of for the sport and season.
+func (m *Model) userDrafts(userID string, sport sleeper.Sport, season string) []sleeper.Draft {
+	drafts := []sleeper.Draft{}
+	for _, l := range m.userLeagues(userID, sport, season) {
+		drafts = append(drafts, m.leagueDrafts(l.LeagueID)...)
+	}
+	return drafts
+}
+
+// Get the drafts for the league.
+func (m *Model) leagueDrafts(leagueID string) []sleeper.Draft {
+	drafts := []sleeper.Draft{}
+	for _, d := range m.Drafts {
+		if d.Draft.LeagueID == leagueID {
+			drafts = append(drafts, d.Draft)
+		}
+	}
+	slices.SortFunc(drafts, func(a, b sleeper.Draft) int { return cmp.Compare(a.DraftID, b.DraftID) })
+	return drafts
+}
@@ -0,0 +1,152 @@
+package sleepertest
+
+import (
+	"fmt"
+	"slices"
+
+	sleeper "github.com/lum8rjack/sleeper-go"
+)
+
+// AddUser adds a Sleeper user that can be looked up by user ID or username.
+func (s *Server) AddUser(user sleeper.User) {
+	s.Update(func(m *Model) {
+		m.Users = append(m.Users, user)
+	})
+}
+
+// AddLeague adds a league with its users and rosters, replacing any league with the same ID.
+func (s *Server) AddLeague(league sleeper.League, users []sleeper.LeagueUser, rosters []sleeper.Roster) {
+	// Copy the rosters so trades do not modify the caller's slices
+	rosters = slices.Clone(rosters)
+	for i := range rosters {
+		rosters[i].Players = slices.Clone(rosters[i].Players)
+		rosters[i].Starters = slices.Clone(rosters[i].Starters)
+	}
+
+	s.Update(func(m *Model) {
+		m.Leagues[league.LeagueID] = &League{
+			League:       league,
+			Users:        slices.Clone(users),
+			Rosters:      rosters,
+			Matchups:     make(map[int][]sleeper.Matchup),
+			Transactions: make(map[int][]sleeper.Transaction),
+		}
+	})
+}
+
+// AddDraft adds a draft with its picks, replacing any draft with the same ID.
+func (s *Server) AddDraft(draft sleeper.Draft, picks []sleeper.DraftPlayer) {
+	s.Update(func(m *Model) {
+		m.Drafts[draft.DraftID] = &Draft{
+			Draft: draft,
+			Picks: picks,
+		}
+	})
+}
+
+// SetSportState sets the state returned for the sport.
+func (s *Server) SetSportState(sport sleeper.Sport, state sleeper.SportState) {
+	s.Update(func(m *Model) {
+		m.SportStates[sport] = state
+	})
+}
+
+// SetPlayers sets the players returned for the sport.
+func (s *Server) SetPlayers(sport sleeper.Sport, players sleeper.Players) {
+	s.Update(func(m *Model) {
+		m.Players[sport] = players
+	})
+}
+
+// SetMatchups sets the matchups for a week of the league.
+func (s *Server) SetMatchups(league_id string, week int, matchups []sleeper.Matchup) error {
+	var err error
+	s.Update(func(m *Model) {
+		league, ok := m.Leagues[league_id]
+		if !ok {
+			err = fmt.Errorf("league %s not found", league_id)
+			return
+		}
+		league.Matchups[week] = matchups
+	})
+	return err
+}
+
+// SetMatchupPoints changes the points scored by a roster in a week of the league.
+func (s *Server) SetMatchupPoints(league_id string, week int, roster_id int, points float32) error {
+	var err error
+	s.Update(func(m *Model) {
+		league, ok := m.Leagues[league_id]
+		if !ok {
+			err = fmt.Errorf("league %s not found", league_id)
+			return
+		}
+
+		for i := range league.Matchups[week] {
+			if league.Matchups[week][i].RosterID == roster_id {
+				league.Matchups[week][i].Points = points
+				return
+			}
+		}
+		err = fmt.Errorf("roster %d has no matchup in week %d", roster_id, week)
+	})
+	return err
+}
+
+// ProcessTrade completes a trade in the league during the week. Players in the
+// transaction's Drops are removed from their rosters, players in Adds are added to
+// the receiving rosters, and the transaction is returned for that week's round.
+func (s *Server) ProcessTrade(league_id string, week int, trade sleeper.Transaction) error {
+	var err error
+	s.Update(func(m *Model) {
+		league, ok := m.Leagues[league_id]
+		if !ok {
+			err = fmt.Errorf("league %s not found", league_id)
+			return
+		}
+
+		rosters := make(map[int]*sleeper.Roster)
+		for i := range league.Rosters {
+			rosters[league.Rosters[i].RosterID] = &league.Rosters[i]
+		}
+
+		for player, rosterID := range trade.Drops {
+			roster, ok := rosters[rosterID]
+			if !ok {
+				err = fmt.Errorf("roster %d not found", rosterID)
+				return
+			}
+			if !slices.Contains(roster.Players, player) {
+				err = fmt.Errorf("player %s is not on roster %d", player, rosterID)
+				return
+			}
+		}
+		for _, rosterID := range trade.Adds {
+			if _, ok := rosters[rosterID]; !ok {
+				err = fmt.Errorf("roster %d not found", rosterID)
+				return
+			}
+		}
+
+		for player, rosterID := range trade.Drops {
+			roster := rosters[rosterID]
+			roster.Players = slices.DeleteFunc(roster.Players, func(p string) bool { return p == player })
+			roster.Starters = slices.DeleteFunc(roster.Starters, func(p string) bool { return p == player })
+		}
+		for player, rosterID := range trade.Adds {
+			rosters[rosterID].Players = append(rosters[rosterID].Players, player)
+		}
+
+		if trade.Type == "" {
+			trade.Type = "trade"
+		}
+		if trade.Status == "" {
+			trade.Status = "complete"
+		}
+		if trade.Leg == 0 {
+			trade.Leg = week
+		}
+		league.Transactions[week] = append(league.Transactions[week], trade)
+	})
+	return err
+}
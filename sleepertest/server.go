@@ -0,0 +1,428 @@
+// Package sleepertest provides an in-process fake of the Sleeper API for testing
+// code built on the sleeper package.
+//
+// The Server implements every route called by sleeper.Client and serves data from
+// a mutable in-memory Model, so tests can script scenarios such as changing scores
+// or processing a trade between calls.
+package sleepertest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	sleeper "github.com/lum8rjack/sleeper-go"
+)
+
+const avatarHost string = "sleepercdn.com"
+
+// Server is a fake Sleeper API server.
+type Server struct {
+	URL string // Base URL of the server, for use as ClientOptions.BaseURL
+
+	server *httptest.Server
+
+	mu       sync.RWMutex
+	model    *Model
+	statuses map[string]int
+	hits     map[string]int
+}
+
+// NewServer starts a new fake Sleeper API server with an empty model.
+// The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		model:    newModel(),
+		statuses: make(map[string]int),
+		hits:     make(map[string]int),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/user/{user}", s.handleUser)
+	mux.HandleFunc("GET /v1/user/{user_id}/leagues/{sport}/{season}", s.handleUserLeagues)
+	mux.HandleFunc("GET /v1/user/{user_id}/drafts/{sport}/{season}", s.handleUserDrafts)
+	mux.HandleFunc("GET /v1/league/{league_id}", s.handleLeague)
+	mux.HandleFunc("GET /v1/league/{league_id}/rosters", s.handleRosters)
+	mux.HandleFunc("GET /v1/league/{league_id}/users", s.handleLeagueUsers)
+	mux.HandleFunc("GET /v1/league/{league_id}/matchups/{week}", s.handleMatchups)
+	mux.HandleFunc("GET /v1/league/{league_id}/winners_bracket", s.handleWinnersBracket)
+	mux.HandleFunc("GET /v1/league/{league_id}/losers_bracket", s.handleLosersBracket)
+	mux.HandleFunc("GET /v1/league/{league_id}/transactions/{round}", s.handleTransactions)
+	mux.HandleFunc("GET /v1/league/{league_id}/traded_picks", s.handleLeagueTradedPicks)
+	mux.HandleFunc("GET /v1/league/{league_id}/drafts", s.handleLeagueDrafts)
+	mux.HandleFunc("GET /v1/state/{sport}", s.handleSportState)
+	mux.HandleFunc("GET /v1/draft/{draft_id}", s.handleDraft)
+	mux.HandleFunc("GET /v1/draft/{draft_id}/picks", s.handleDraftPicks)
+	mux.HandleFunc("GET /v1/draft/{draft_id}/traded_picks", s.handleDraftTradedPicks)
+	mux.HandleFunc("GET /v1/players/{sport}", s.handlePlayers)
+	mux.HandleFunc("GET /v1/players/{sport}/trending/{type}", s.handleTrendingPlayers)
+	mux.HandleFunc("GET /player/{sport}/{player_id}", s.handlePlayer)
+	mux.HandleFunc("GET /players/{sport}/research/{season_type}/{season}/{week}", s.handlePlayerResearch)
+	mux.HandleFunc("GET /stats/{sport}/player/{player_id}", s.handlePlayerStats)
+	mux.HandleFunc("GET /projections/{sport}/{season}/{week}", s.handleProjections)
+	mux.HandleFunc("GET /schedule/{sport}/{season_type}/{season}", s.handleSchedule)
+	mux.HandleFunc("GET /players/nfl/{team}/depth_chart", s.handleNflTeamDepthChart)
+	mux.HandleFunc("GET /avatars/{avatar_id}", s.handleAvatar)
+	mux.HandleFunc("GET /avatars/thumbs/{avatar_id}", s.handleAvatar)
+
+	s.server = httptest.NewServer(s.middleware(mux))
+	s.URL = s.server.URL
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client creates a sleeper.Client that sends every request, including avatar requests,
+// to the server. BaseURL is always replaced and the rate limit is raised unless set.
+func (s *Server) Client(opts sleeper.ClientOptions) sleeper.Client {
+	opts.BaseURL = s.URL
+	if opts.RateLimit <= 0 {
+		opts.RateLimit = 1000
+	}
+	opts.Middleware = append(opts.Middleware, s.rewriteAvatars)
+	return sleeper.NewClientWithOptions(opts)
+}
+
+// Send avatar requests to the server instead of the Sleeper CDN.
+func (s *Server) rewriteAvatars(next sleeper.RoundTripFunc) sleeper.RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == avatarHost {
+			target, err := url.Parse(s.URL)
+			if err != nil {
+				return nil, err
+			}
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			req.Host = target.Host
+		}
+		return next(req)
+	}
+}
+
+// Update changes the model while holding the server's lock.
+func (s *Server) Update(fn func(m *Model)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn(s.model)
+}
+
+// SetStatus makes every request for the URL path fail with the status code.
+// A status of zero or 200 removes the failure.
+func (s *Server) SetStatus(path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status == 0 || status == http.StatusOK {
+		delete(s.statuses, path)
+		return
+	}
+	s.statuses[path] = status
+}
+
+// Hits returns the number of requests received for the URL path.
+func (s *Server) Hits(path string) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.hits[path]
+}
+
+// Count requests and return any forced status before calling the handler.
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.hits[r.URL.Path]++
+		status := s.statuses[r.URL.Path]
+		s.mu.Unlock()
+
+		if status != 0 {
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Write the value as a JSON response.
+func writeJSON(w http.ResponseWriter, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// Write the value as a JSON response, or a 404 if it was not found.
+func writeFound(w http.ResponseWriter, v any, found bool) {
+	if !found {
+		http.NotFound(w, nil)
+		return
+	}
+	writeJSON(w, v)
+}
+
+// Get an integer path value, writing a 400 response if it is invalid.
+func intPathValue(w http.ResponseWriter, r *http.Request, name string) (int, bool) {
+	value, err := strconv.Atoi(r.PathValue(name))
+	if err != nil {
+		http.Error(w, "invalid "+name, http.StatusBadRequest)
+		return 0, false
+	}
+	return value, true
+}
+
+// Get the sport from the request path.
+func sportPathValue(r *http.Request) sleeper.Sport {
+	return sleeper.Sport(r.PathValue("sport"))
+}
+
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.model.user(r.PathValue("user"))
+	writeFound(w, user, ok)
+}
+
+func (s *Server) handleUserLeagues(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.model.userLeagues(r.PathValue("user_id"), sportPathValue(r), r.PathValue("season")))
+}
+
+func (s *Server) handleUserDrafts(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.model.userDrafts(r.PathValue("user_id"), sportPathValue(r), r.PathValue("season")))
+}
+
+// Get the league for the request, writing null if it does not exist like the real API.
+// Use SetStatus to return a 404 instead.
+func (s *Server) league(w http.ResponseWriter, r *http.Request) (*League, bool) {
+	league, ok := s.model.Leagues[r.PathValue("league_id")]
+	if !ok {
+		writeJSON(w, nil)
+	}
+	return league, ok
+}
+
+func (s *Server) handleLeague(w http.ResponseWriter, r *http.Request) {
+	if league, ok := s.league(w, r); ok {
+		writeJSON(w, league.League)
+	}
+}
+
+func (s *Server) handleRosters(w http.ResponseWriter, r *http.Request) {
+	if league, ok := s.league(w, r); ok {
+		writeJSON(w, nonNil(league.Rosters))
+	}
+}
+
+func (s *Server) handleLeagueUsers(w http.ResponseWriter, r *http.Request) {
+	if league, ok := s.league(w, r); ok {
+		writeJSON(w, nonNil(league.Users))
+	}
+}
+
+func (s *Server) handleMatchups(w http.ResponseWriter, r *http.Request) {
+	league, ok := s.league(w, r)
+	if !ok {
+		return
+	}
+	week, ok := intPathValue(w, r, "week")
+	if !ok {
+		return
+	}
+	writeJSON(w, nonNil(league.Matchups[week]))
+}
+
+func (s *Server) handleWinnersBracket(w http.ResponseWriter, r *http.Request) {
+	if league, ok := s.league(w, r); ok {
+		writeJSON(w, nonNil(league.WinnersBracket))
+	}
+}
+
+func (s *Server) handleLosersBracket(w http.ResponseWriter, r *http.Request) {
+	if league, ok := s.league(w, r); ok {
+		writeJSON(w, nonNil(league.LosersBracket))
+	}
+}
+
+func (s *Server) handleTransactions(w http.ResponseWriter, r *http.Request) {
+	league, ok := s.league(w, r)
+	if !ok {
+		return
+	}
+	round, ok := intPathValue(w, r, "round")
+	if !ok {
+		return
+	}
+	writeJSON(w, nonNil(league.Transactions[round]))
+}
+
+func (s *Server) handleLeagueTradedPicks(w http.ResponseWriter, r *http.Request) {
+	if league, ok := s.league(w, r); ok {
+		writeJSON(w, nonNil(league.TradedPicks))
+	}
+}
+
+func (s *Server) handleLeagueDrafts(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.league(w, r); ok {
+		writeJSON(w, s.model.leagueDrafts(r.PathValue("league_id")))
+	}
+}
+
+func (s *Server) handleSportState(w http.ResponseWriter, r *http.Request) {
+	state, ok := s.model.SportStates[sportPathValue(r)]
+	writeFound(w, state, ok)
+}
+
+// Get the draft for the request, writing null if it does not exist like the real API.
+// Use SetStatus to return a 404 instead.
+func (s *Server) draft(w http.ResponseWriter, r *http.Request) (*Draft, bool) {
+	draft, ok := s.model.Drafts[r.PathValue("draft_id")]
+	if !ok {
+		writeJSON(w, nil)
+	}
+	return draft, ok
+}
+
+func (s *Server) handleDraft(w http.ResponseWriter, r *http.Request) {
+	if draft, ok := s.draft(w, r); ok {
+		writeJSON(w, draft.Draft)
+	}
+}
+
+func (s *Server) handleDraftPicks(w http.ResponseWriter, r *http.Request) {
+	if draft, ok := s.draft(w, r); ok {
+		writeJSON(w, nonNil(draft.Picks))
+	}
+}
+
+func (s *Server) handleDraftTradedPicks(w http.ResponseWriter, r *http.Request) {
+	if draft, ok := s.draft(w, r); ok {
+		writeJSON(w, nonNil(draft.TradedPicks))
+	}
+}
+
+func (s *Server) handlePlayers(w http.ResponseWriter, r *http.Request) {
+	players, ok := s.model.Players[sportPathValue(r)]
+	if !ok {
+		players = sleeper.Players{}
+	}
+	writeJSON(w, players)
+}
+
+func (s *Server) handleTrendingPlayers(w http.ResponseWriter, r *http.Request) {
+	trending := nonNil(s.model.Trending[r.PathValue("sport")+"/"+r.PathValue("type")])
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit >= 0 && limit < len(trending) {
+		trending = trending[:limit]
+	}
+	writeJSON(w, trending)
+}
+
+func (s *Server) handlePlayer(w http.ResponseWriter, r *http.Request) {
+	player, ok := s.model.Players[sportPathValue(r)][r.PathValue("player_id")]
+	writeFound(w, player, ok)
+}
+
+func (s *Server) handlePlayerResearch(w http.ResponseWriter, r *http.Request) {
+	season, ok := intPathValue(w, r, "season")
+	if !ok {
+		return
+	}
+	week, ok := intPathValue(w, r, "week")
+	if !ok {
+		return
+	}
+	key := WeekKey{Sport: sportPathValue(r), Season: season, Week: week, Postseason: r.PathValue("season_type") == "post"}
+
+	research, ok := s.model.Research[key]
+	if !ok {
+		research = map[string]sleeper.PlayerResearch{}
+	}
+	writeJSON(w, research)
+}
+
+func (s *Server) handlePlayerStats(w http.ResponseWriter, r *http.Request) {
+	season, err := strconv.Atoi(r.URL.Query().Get("season"))
+	if err != nil {
+		http.Error(w, "invalid season", http.StatusBadRequest)
+		return
+	}
+	key := PlayerSeasonKey{
+		Sport:      sportPathValue(r),
+		PlayerID:   r.PathValue("player_id"),
+		Season:     season,
+		Postseason: r.URL.Query().Get("season_type") == "post",
+	}
+
+	stats, ok := s.model.SeasonStats[key]
+	writeFound(w, stats, ok)
+}
+
+func (s *Server) handleProjections(w http.ResponseWriter, r *http.Request) {
+	season, ok := intPathValue(w, r, "season")
+	if !ok {
+		return
+	}
+	week, ok := intPathValue(w, r, "week")
+	if !ok {
+		return
+	}
+
+	projections, ok := s.model.Projections[WeekKey{Sport: sportPathValue(r), Season: season, Week: week}]
+	if !ok {
+		projections = sleeper.Projections{}
+	}
+	writeJSON(w, projections)
+}
+
+func (s *Server) handleSchedule(w http.ResponseWriter, r *http.Request) {
+	season, ok := intPathValue(w, r, "season")
+	if !ok {
+		return
+	}
+	key := SeasonKey{Sport: sportPathValue(r), Season: season, Postseason: r.PathValue("season_type") == "post"}
+
+	schedule, ok := s.model.Schedules[key]
+	if !ok {
+		schedule = sleeper.Schedule{}
+	}
+	writeJSON(w, schedule)
+}
+
+func (s *Server) handleNflTeamDepthChart(w http.ResponseWriter, r *http.Request) {
+	depthChart, ok := s.model.DepthCharts[strings.ToUpper(r.PathValue("team"))]
+	writeFound(w, depthChart, ok)
+}
+
+func (s *Server) handleAvatar(w http.ResponseWriter, r *http.Request) {
+	avatar, ok := s.model.Avatars[r.PathValue("avatar_id")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", http.DetectContentType(avatar))
+	w.WriteHeader(http.StatusOK)
+	w.Write(avatar)
+}
+
+// Return an empty slice instead of nil so it is encoded as [] like the real API.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
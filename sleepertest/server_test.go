@@ -0,0 +1,317 @@
+package sleepertest
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	sleeper "github.com/lum8rjack/sleeper-go"
+)
+
+func newTestLeague(s *Server) {
+	league := sleeper.League{LeagueID: "100", Name: "Test League", Sport: "nfl", Season: "2024", DraftID: "500"}
+
+	users := []sleeper.LeagueUser{
+		{UserID: "1", DisplayName: "alice"},
+		{UserID: "2", DisplayName: "bob"},
+	}
+	users[0].Metadata.TeamName = "Alice Team"
+	users[1].Metadata.TeamName = "Bob Team"
+
+	rosters := []sleeper.Roster{
+		{RosterID: 1, OwnerID: "1", LeagueID: "100", Players: []string{"10", "11"}},
+		{RosterID: 2, OwnerID: "2", LeagueID: "100", Players: []string{"20", "21"}},
+	}
+
+	s.AddUser(sleeper.User{UserID: "1", Username: "alice", DisplayName: "alice"})
+	s.AddLeague(league, users, rosters)
+	s.SetMatchups("100", 5, []sleeper.Matchup{
+		{RosterID: 1, MatchupID: 1, Points: 100},
+		{RosterID: 2, MatchupID: 1, Points: 90},
+	})
+	s.SetSportState("nfl", sleeper.SportState{Season: "2024", Week: 5, SeasonType: "regular"})
+	s.AddDraft(sleeper.Draft{DraftID: "500", LeagueID: "100", Sport: "nfl", Season: "2024"}, []sleeper.DraftPlayer{
+		{DraftID: "500", PickNo: 1, PlayerID: "10", RosterID: 1},
+	})
+}
+
+func TestServerRoutes(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	newTestLeague(s)
+	s.SetPlayers("nfl", sleeper.Players{"10": {PlayerID: "10", FullName: "Player Ten"}})
+	s.Update(func(m *Model) {
+		m.Trending["nfl/add"] = []sleeper.TrendingPlayer{{PlayerID: "10", Count: 5}, {PlayerID: "11", Count: 3}}
+		m.Projections[WeekKey{Sport: sleeper.SportNFL, Season: 2024, Week: 5}] = sleeper.Projections{{PlayerID: "10"}}
+		m.Schedules[SeasonKey{Sport: sleeper.SportNFL, Season: 2024}] = sleeper.Schedule{{GameID: "g1", Home: "BUF", Away: "MIA", Week: 1}}
+		m.Research[WeekKey{Sport: sleeper.SportNFL, Season: 2024, Week: 5}] = map[string]sleeper.PlayerResearch{"10": {Owned: 99.5}}
+		m.SeasonStats[PlayerSeasonKey{Sport: sleeper.SportNFL, PlayerID: "10", Season: 2024}] = sleeper.PlayerStats{Season: "2024"}
+		m.DepthCharts["BUF"] = sleeper.TeamDepthChart{Qb: []string{"10"}}
+		m.Avatars["abc"] = []byte("avatar")
+		m.Leagues["100"].WinnersBracket = []sleeper.PlayoffRound{{R: 1, M: 1, T1: 1, T2: 2}}
+		m.Leagues["100"].TradedPicks = []sleeper.TradedPick{{Round: 1, Season: "2025", RosterID: 1, OwnerID: 2}}
+	})
+
+	client := s.Client(sleeper.ClientOptions{})
+
+	checks := []struct {
+		name string
+		call func() (bool, error)
+	}{
+		{"GetUserByUsername", func() (bool, error) {
+			u, err := client.GetUserByUsername("alice")
+			return u.UserID == "1", err
+		}},
+		{"GetUserByID", func() (bool, error) {
+			u, err := client.GetUserByID("1")
+			return u.Username == "alice", err
+		}},
+		{"GetAllLeagesForUser", func() (bool, error) {
+			l, err := client.GetAllLeagesForUser("1", "nfl", 2024)
+			return len(l) == 1 && l[0].LeagueID == "100", err
+		}},
+		{"GetDraftsForUser", func() (bool, error) {
+			d, err := client.GetDraftsForUser("1", "nfl", 2024)
+			return len(d) == 1 && d[0].DraftID == "500", err
+		}},
+		{"GetLeague", func() (bool, error) {
+			l, err := client.GetLeague("100")
+			return l.Name == "Test League", err
+		}},
+		{"GetRosters", func() (bool, error) {
+			r, err := client.GetRosters("100")
+			return len(r) == 2, err
+		}},
+		{"GetLeagueUsers", func() (bool, error) {
+			u, err := client.GetLeagueUsers("100")
+			return len(u) == 2, err
+		}},
+		{"GetMatchups", func() (bool, error) {
+			m, err := client.GetMatchups("100", 5)
+			return len(m) == 2, err
+		}},
+		{"GetPlayoffsWinnersBracket", func() (bool, error) {
+			b, err := client.GetPlayoffsWinnersBracket("100")
+			return len(b) == 1, err
+		}},
+		{"GetPlayoffsLosersBracket", func() (bool, error) {
+			b, err := client.GetPlayoffsLosersBracket("100")
+			return len(b) == 0, err
+		}},
+		{"GetTransactions", func() (bool, error) {
+			tx, err := client.GetTransactions("100", 1)
+			return len(tx) == 0, err
+		}},
+		{"GetLeagueTradedPicks", func() (bool, error) {
+			p, err := client.GetLeagueTradedPicks("100")
+			return len(p) == 1, err
+		}},
+		{"GetDraftsForLeague", func() (bool, error) {
+			d, err := client.GetDraftsForLeague("100")
+			return len(d) == 1, err
+		}},
+		{"GetSportState", func() (bool, error) {
+			st, err := client.GetSportState("nfl")
+			return st.Week == 5, err
+		}},
+		{"GetDraft", func() (bool, error) {
+			d, err := client.GetDraft("500")
+			return d.LeagueID == "100", err
+		}},
+		{"GetAllDraftPicks", func() (bool, error) {
+			p, err := client.GetAllDraftPicks("500")
+			return len(p) == 1, err
+		}},
+		{"GetDraftTradedPicks", func() (bool, error) {
+			p, err := client.GetDraftTradedPicks("500")
+			return len(p) == 0, err
+		}},
+		{"GetAllPlayers", func() (bool, error) {
+			p, err := client.GetAllPlayers("nfl")
+			return p["10"].FullName == "Player Ten", err
+		}},
+		{"GetTrendingPlayersParams", func() (bool, error) {
+			p, err := client.GetTrendingPlayersParams("nfl", "add", 24, 1)
+			return len(p) == 1 && p[0].PlayerID == "10", err
+		}},
+		{"GetNflPlayer", func() (bool, error) {
+			p, err := client.GetNflPlayer(10)
+			return p.FullName == "Player Ten", err
+		}},
+		{"GetNflPlayerResearch", func() (bool, error) {
+			r, err := client.GetNflPlayerResearch(2024, 5, false)
+			return r["10"].Owned == 99.5, err
+		}},
+		{"GetNflPlayerSeasonStats", func() (bool, error) {
+			st, err := client.GetNflPlayerSeasonStats(10, 2024, false)
+			return st.Season == "2024", err
+		}},
+		{"GetNflProjections", func() (bool, error) {
+			p, err := client.GetNflProjections(2024, 5)
+			return len(p) == 1, err
+		}},
+		{"GetNflSchedule", func() (bool, error) {
+			sch, err := client.GetNflSchedule(2024, false)
+			return len(sch) == 1 && sch[0].Home == "BUF", err
+		}},
+		{"GetNflTeamDepthChart", func() (bool, error) {
+			d, err := client.GetNflTeamDepthChart("BUF")
+			return slices.Equal(d.Qb, []string{"10"}), err
+		}},
+		{"GetAvatar", func() (bool, error) {
+			a, err := client.GetAvatar("abc")
+			return string(a) == "avatar", err
+		}},
+		{"GetAvatarThumbnail", func() (bool, error) {
+			a, err := client.GetAvatarThumbnail("abc")
+			return string(a) == "avatar", err
+		}},
+		{"GetScoreboards", func() (bool, error) {
+			sb, err := client.GetScoreboards("100", 0)
+			return len(sb) == 1 && sb[0].Points1+sb[0].Points2 == 190, err
+		}},
+	}
+
+	for _, check := range checks {
+		t.Run(check.name, func(t *testing.T) {
+			ok, err := check.call()
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !ok {
+				t.Error("Unexpected response")
+			}
+		})
+	}
+}
+
+func TestServerNotFound(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	client := s.Client(sleeper.ClientOptions{})
+
+	// Missing leagues and drafts are null like the real API
+	league, err := client.GetLeague("missing")
+	if err != nil || league.LeagueID != "" {
+		t.Errorf("Expected an empty league, got %+v and %v", league, err)
+	}
+	draft, err := client.GetDraft("missing")
+	if err != nil || draft.DraftID != "" {
+		t.Errorf("Expected an empty draft, got %+v and %v", draft, err)
+	}
+	if rosters, err := client.GetRosters("missing"); err != nil || len(rosters) != 0 {
+		t.Errorf("Expected no rosters, got %+v and %v", rosters, err)
+	}
+
+	// A 404 can still be set for a path
+	s.SetStatus("/v1/league/missing", http.StatusNotFound)
+	if _, err := client.GetLeague("missing"); !errors.Is(err, sleeper.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestServerSetStatus(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	newTestLeague(s)
+	client := s.Client(sleeper.ClientOptions{
+		Retry: sleeper.RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond},
+	})
+
+	s.SetStatus("/v1/league/100", http.StatusServiceUnavailable)
+	if _, err := client.GetLeague("100"); !errors.Is(err, sleeper.ErrServerError) {
+		t.Errorf("Expected ErrServerError, got %v", err)
+	}
+	if hits := s.Hits("/v1/league/100"); hits != 3 {
+		t.Errorf("Expected 3 hits, got %d", hits)
+	}
+
+	s.SetStatus("/v1/league/100", 0)
+	if _, err := client.GetLeague("100"); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestScenarioScoresChange(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	newTestLeague(s)
+	client := s.Client(sleeper.ClientOptions{})
+
+	if err := s.SetMatchupPoints("100", 5, 2, 120.5); err != nil {
+		t.Fatalf("Failed to set points: %v", err)
+	}
+
+	scoreboards, err := client.GetScoreboards("100", 5)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(scoreboards) != 1 {
+		t.Fatalf("Expected 1 scoreboard, got %d", len(scoreboards))
+	}
+	if scoreboards[0].Points1 != 100 || scoreboards[0].Points2 != 120.5 {
+		t.Errorf("Expected points 100 and 120.5, got %+v", scoreboards[0])
+	}
+
+	if err := s.SetMatchupPoints("100", 5, 9, 1); err == nil {
+		t.Error("Expected error for unknown roster")
+	}
+	if err := s.SetMatchupPoints("missing", 5, 1, 1); err == nil {
+		t.Error("Expected error for unknown league")
+	}
+}
+
+func TestScenarioProcessTrade(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	newTestLeague(s)
+	client := s.Client(sleeper.ClientOptions{})
+
+	trade := sleeper.Transaction{
+		TransactionID: "t1",
+		RosterIds:     []int{1, 2},
+		Adds:          map[string]int{"10": 2, "20": 1},
+		Drops:         map[string]int{"10": 1, "20": 2},
+	}
+	if err := s.ProcessTrade("100", 6, trade); err != nil {
+		t.Fatalf("Failed to process trade: %v", err)
+	}
+
+	rosters, err := client.GetRosters("100")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, r := range rosters {
+		players := slices.Sorted(slices.Values(r.Players))
+		switch r.RosterID {
+		case 1:
+			if !slices.Equal(players, []string{"11", "20"}) {
+				t.Errorf("Expected roster 1 players [11 20], got %v", players)
+			}
+		case 2:
+			if !slices.Equal(players, []string{"10", "21"}) {
+				t.Errorf("Expected roster 2 players [10 21], got %v", players)
+			}
+		}
+	}
+
+	transactions, err := client.GetTransactions("100", 6)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(transactions) != 1 || transactions[0].Type != "trade" || transactions[0].Status != "complete" {
+		t.Errorf("Expected one completed trade, got %+v", transactions)
+	}
+
+	bad := sleeper.Transaction{Drops: map[string]int{"99": 1}}
+	if err := s.ProcessTrade("100", 6, bad); err == nil {
+		t.Error("Expected error for player not on roster")
+	}
+}
@@ -0,0 +1,217 @@
+package sleeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// PlayerSnapshotMaxAge is how often Sleeper says the players endpoint needs to be called.
+const PlayerSnapshotMaxAge time.Duration = 24 * time.Hour
+
+// PlayerSnapshotInfo describes a saved players snapshot. It is stored in a sidecar
+// file next to the snapshot.
+type PlayerSnapshotInfo struct {
+	Sport     Sport     `json:"sport"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Players   int       `json:"players"`
+	Bytes     int64     `json:"bytes"`
+}
+
+// PlayerSnapshotStore saves the players for each sport to a directory and only
+// downloads them again when the saved snapshot is too old.
+//
+// Snapshots are written to a temporary file and renamed into place, so a crash
+// never leaves a truncated snapshot behind.
+type PlayerSnapshotStore struct {
+	client *Client
+	dir    string
+	now    func() time.Time
+}
+
+// NewPlayerSnapshotStore creates a snapshot store in the directory, creating it if needed.
+func NewPlayerSnapshotStore(client *Client, dir string) (*PlayerSnapshotStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &PlayerSnapshotStore{
+		client: client,
+		dir:    dir,
+		now:    time.Now,
+	}, nil
+}
+
+// Path returns the file the players snapshot for the sport is saved to.
+func (s *PlayerSnapshotStore) Path(sport Sport) string {
+	return playerSnapshotPath(s.dir, sport)
+}
+
+// Get the file the players snapshot for the sport is saved to in the directory.
+func playerSnapshotPath(dir string, sport Sport) string {
+	return filepath.Join(dir, fmt.Sprintf("players_%s.json", sport))
+}
+
+// Get the sidecar file with the snapshot info.
+func (s *PlayerSnapshotStore) infoPath(sport Sport) string {
+	return filepath.Join(s.dir, fmt.Sprintf("players_%s.meta.json", sport))
+}
+
+// Info returns the info for the saved snapshot. The error wraps fs.ErrNotExist if
+// there is no snapshot for the sport.
+func (s *PlayerSnapshotStore) Info(sport Sport) (PlayerSnapshotInfo, error) {
+	info := PlayerSnapshotInfo{}
+
+	data, err := os.ReadFile(s.infoPath(sport))
+	if err != nil {
+		return info, err
+	}
+
+	if err := json.Unmarshal(data, &info); err != nil {
+		return info, fmt.Errorf("invalid snapshot info for %s: %w", sport, err)
+	}
+
+	// The snapshot may have been removed without its sidecar
+	if _, err := os.Stat(s.Path(sport)); err != nil {
+		return PlayerSnapshotInfo{}, err
+	}
+
+	return info, nil
+}
+
+// Load reads the saved players snapshot for the sport.
+func (s *PlayerSnapshotStore) Load(sport Sport) (Players, error) {
+	return GetAllPlayers(s.Path(sport))
+}
+
+// Refresh downloads the players for the sport and saves a new snapshot.
+func (s *PlayerSnapshotStore) Refresh(sport Sport) (PlayerSnapshotInfo, error) {
+	return s.RefreshContext(context.Background(), sport)
+}
+
+// RefreshContext is like Refresh but accepts a context.
+func (s *PlayerSnapshotStore) RefreshContext(ctx context.Context, sport Sport) (PlayerSnapshotInfo, error) {
+	info := PlayerSnapshotInfo{Sport: sport}
+
+	if err := sport.Validate(); err != nil {
+		return info, err
+	}
+
+	url := fmt.Sprintf("%s/v1/players/%s", s.client.sleeperURL, sport)
+
+	body, err := s.client.getStreamContext(ctx, url)
+	if err != nil {
+		return info, err
+	}
+	defer body.Close()
+
+	// Validate the players while streaming them to disk so a bad response is never saved
+	err = writeFileAtomic(s.Path(sport), func(w io.Writer) error {
+		counter := &countingWriter{w: w}
+		err := DecodePlayers(io.TeeReader(body, counter), func(id string, player Player) error {
+			info.Players++
+			return nil
+		})
+		info.Bytes = counter.n
+		return err
+	})
+	if err != nil {
+		return info, err
+	}
+
+	info.FetchedAt = s.now().UTC()
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return info, err
+	}
+
+	err = writeFileAtomic(s.infoPath(sport), func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+
+	return info, err
+}
+
+// LoadOrRefresh loads the saved players for the sport, downloading them first if there
+// is no snapshot or it is older than maxAge. A maxAge of zero or less uses PlayerSnapshotMaxAge.
+// When the client is offline an existing snapshot is always used.
+func (s *PlayerSnapshotStore) LoadOrRefresh(sport Sport, maxAge time.Duration) (Players, error) {
+	return s.LoadOrRefreshContext(context.Background(), sport, maxAge)
+}
+
+// LoadOrRefreshContext is like LoadOrRefresh but accepts a context.
+func (s *PlayerSnapshotStore) LoadOrRefreshContext(ctx context.Context, sport Sport, maxAge time.Duration) (Players, error) {
+	if maxAge <= 0 {
+		maxAge = PlayerSnapshotMaxAge
+	}
+
+	info, err := s.Info(sport)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return Players{}, err
+	}
+
+	// Use the saved snapshot however old it is when the client is offline
+	if err == nil && s.client.offline {
+		return s.Load(sport)
+	}
+
+	if err != nil || s.now().Sub(info.FetchedAt) > maxAge {
+		if _, err := s.RefreshContext(ctx, sport); err != nil {
+			return Players{}, err
+		}
+	}
+
+	return s.Load(sport)
+}
+
+// Write a file by writing to a temporary file in the same directory and renaming it
+// over the destination once fn succeeds. The file is readable by other users like one
+// written by os.WriteFile, instead of the 0600 mode of temporary files.
+func writeFileAtomic(file string, fn func(w io.Writer) error) error {
+	tmp, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := fn(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), file)
+}
+
+// Writer that counts the bytes written.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,250 @@
+package sleeper
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPlayerSnapshotStore(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"1": {"player_id": "1", "full_name": "Test Player 1"}, "2": {"player_id": "2"}}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+	})
+
+	store, err := NewPlayerSnapshotStore(&client, t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	if _, err := store.Info("nfl"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected fs.ErrNotExist before the first refresh, got %v", err)
+	}
+
+	players, err := store.LoadOrRefresh("nfl", 0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(players) != 2 || players["1"].FullName != "Test Player 1" {
+		t.Errorf("Unexpected players %v", players)
+	}
+
+	info, err := store.Info("nfl")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if info.Sport != "nfl" || !info.FetchedAt.Equal(now) || info.Players != 2 || info.Bytes == 0 {
+		t.Errorf("Unexpected snapshot info %+v", info)
+	}
+
+	// Within the max age the snapshot is read from disk
+	now = now.Add(23 * time.Hour)
+	if _, err := store.LoadOrRefresh("nfl", 0); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("Expected 1 request, got %d", n)
+	}
+
+	// Once the snapshot is older than a day it is fetched again
+	now = now.Add(2 * time.Hour)
+	if _, err := store.LoadOrRefresh("nfl", 0); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if n := hits.Load(); n != 2 {
+		t.Errorf("Expected 2 requests, got %d", n)
+	}
+
+	// A custom max age is honored
+	now = now.Add(2 * time.Hour)
+	if _, err := store.LoadOrRefresh("nfl", time.Hour); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if n := hits.Load(); n != 3 {
+		t.Errorf("Expected 3 requests, got %d", n)
+	}
+}
+
+func TestPlayerSnapshotStoreKeepsSnapshotOnFailure(t *testing.T) {
+	body := `{"1": {"player_id": "1"}}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+	})
+
+	dir := t.TempDir()
+	store, err := NewPlayerSnapshotStore(&client, dir)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+
+	if _, err := store.Refresh("nfl"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	before, _ := store.Info("nfl")
+
+	// A truncated response is rejected and the previous snapshot is kept
+	body = `{"1": {"player_id": "1"}, "2": {"play`
+	if _, err := store.Refresh("nfl"); err == nil {
+		t.Fatal("Expected error for truncated response")
+	}
+
+	players, err := store.Load("nfl")
+	if err != nil {
+		t.Fatalf("Expected previous snapshot to load, got %v", err)
+	}
+	if len(players) != 1 {
+		t.Errorf("Expected 1 player, got %d", len(players))
+	}
+	if after, _ := store.Info("nfl"); after != before {
+		t.Errorf("Expected snapshot info to be unchanged, got %+v", after)
+	}
+
+	// No temporary files are left behind
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		if entry.Name() != filepath.Base(store.Path("nfl")) && entry.Name() != filepath.Base(store.infoPath("nfl")) {
+			t.Errorf("Unexpected file %s", entry.Name())
+		}
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "players.json")
+	if err := os.WriteFile(file, []byte("original"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	errWrite := errors.New("write failed")
+	err := writeFileAtomic(file, func(w io.Writer) error {
+		w.Write([]byte("partial"))
+		return errWrite
+	})
+	if !errors.Is(err, errWrite) {
+		t.Errorf("Expected write error, got %v", err)
+	}
+
+	data, _ := os.ReadFile(file)
+	if string(data) != "original" {
+		t.Errorf("Expected original file to be kept, got %s", data)
+	}
+}
+
+func TestWriteFileAtomicMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not supported on windows")
+	}
+
+	file := filepath.Join(t.TempDir(), "players.json")
+	err := writeFileAtomic(file, func(w io.Writer) error {
+		_, err := w.Write([]byte("{}"))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	info, err := os.Stat(file)
+	if err != nil {
+		t.Fatalf("Failed to stat file: %v", err)
+	}
+	if mode := info.Mode().Perm(); mode != 0644 {
+		t.Errorf("Expected mode 0644, got %o", mode)
+	}
+}
+
+func TestPlayerSnapshotStoreInvalidSport(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+	})
+
+	dir := t.TempDir()
+	store, err := NewPlayerSnapshotStore(&client, dir)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+
+	if _, err := store.Refresh("../nfl"); !errors.Is(err, ErrInvalidSport) {
+		t.Errorf("Expected ErrInvalidSport, got %v", err)
+	}
+	if hits.Load() != 0 {
+		t.Errorf("Expected 0 requests, got %d", hits.Load())
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Expected no files, got %d", len(entries))
+	}
+}
+
+func TestPlayerSnapshotStoreRefreshSkipsCache(t *testing.T) {
+	var hits atomic.Int32
+	team := "BUF"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"1": {"player_id": "1", "team": %q}}`, team)
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Cache:   NewMemoryCache(0),
+	})
+
+	store, err := NewPlayerSnapshotStore(&client, t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+
+	if _, err := store.Refresh("nfl"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// The player is traded, and refreshing again within the cache TTL gets the new team
+	team = "MIA"
+	if _, err := store.Refresh("nfl"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if n := hits.Load(); n != 2 {
+		t.Errorf("Expected 2 requests, got %d", n)
+	}
+
+	players, err := store.Load("nfl")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if players["1"].Team != "MIA" {
+		t.Errorf("Expected team MIA, got %s", players["1"].Team)
+	}
+}
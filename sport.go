@@ -0,0 +1,115 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Sport is a sport supported by Sleeper.
+type Sport string
+
+const (
+	SportNFL Sport = "nfl"
+	SportNBA Sport = "nba"
+	SportLCS Sport = "lcs"
+)
+
+// Sports is every sport supported by Sleeper.
+var Sports = []Sport{SportNFL, SportNBA, SportLCS}
+
+// ErrInvalidSport is returned when a method is called with a sport Sleeper does not support.
+var ErrInvalidSport = errors.New("sleeper: invalid sport")
+
+// Positions and scoring stats for each sport. Stats are the keys used in Sleeper's
+// stats and projections responses.
+var sportInfo = map[Sport]struct {
+	positions []string
+	stats     []string
+}{
+	SportNFL: {
+		positions: []string{"QB", "RB", "WR", "TE", "K", "DEF", "DL", "LB", "DB"},
+		stats: []string{
+			"pass_yd", "pass_td", "pass_int", "pass_2pt",
+			"rush_yd", "rush_td", "rush_2pt",
+			"rec", "rec_yd", "rec_td", "rec_2pt",
+			"fum_lost", "fgm", "fgmiss", "xpm", "xpmiss",
+			"def_td", "sack", "int", "fum_rec", "safe", "pts_allow",
+			"pts_std", "pts_half_ppr", "pts_ppr",
+		},
+	},
+	SportNBA: {
+		positions: []string{"PG", "SG", "SF", "PF", "C", "G", "F"},
+		stats: []string{
+			"pts", "reb", "ast", "stl", "blk", "to",
+			"fgm", "fga", "ftm", "fta", "tpm", "tpa",
+			"dd", "td",
+		},
+	},
+	SportLCS: {
+		positions: []string{"TOP", "JG", "MID", "ADC", "SUP", "TEAM"},
+		stats: []string{
+			"kills", "deaths", "assists", "cs",
+			"triple_kills", "quadra_kills", "penta_kills",
+			"towers", "dragons", "barons", "first_blood", "win",
+		},
+	},
+}
+
+// ParseSport returns the sport for a name such as "nfl" or "NBA".
+func ParseSport(name string) (Sport, error) {
+	sport := Sport(strings.ToLower(strings.TrimSpace(name)))
+	if err := sport.Validate(); err != nil {
+		return "", err
+	}
+	return sport, nil
+}
+
+// Valid reports whether the sport is supported by Sleeper.
+func (s Sport) Valid() bool {
+	return slices.Contains(Sports, s)
+}
+
+// Validate returns an error wrapping ErrInvalidSport if the sport is not supported by Sleeper.
+func (s Sport) Validate() error {
+	if !s.Valid() {
+		return fmt.Errorf("%w %q - must be one of nfl, nba, lcs", ErrInvalidSport, string(s))
+	}
+	return nil
+}
+
+// String returns the name Sleeper uses for the sport in URLs.
+func (s Sport) String() string {
+	return string(s)
+}
+
+// Positions returns the player positions used in the sport.
+func (s Sport) Positions() []string {
+	return slices.Clone(sportInfo[s].positions)
+}
+
+// Stats returns the common scoring stat keys for the sport.
+func (s Sport) Stats() []string {
+	return slices.Clone(sportInfo[s].stats)
+}
+
+// Decode the stats object of a raw projection or stats response. The Stats structs only
+// declare NFL stats, so this is how stats for other sports are read.
+func rawStats(raw json.RawMessage) map[string]float64 {
+	var response struct {
+		Stats map[string]any `json:"stats"`
+	}
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return nil
+	}
+
+	stats := make(map[string]float64, len(response.Stats))
+	for key, value := range response.Stats {
+		if n, ok := value.(float64); ok {
+			stats[key] = n
+		}
+	}
+	return stats
+}
@@ -0,0 +1,114 @@
+package sleeper
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParseSport(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Sport
+		wantErr bool
+	}{
+		{"nfl", SportNFL, false},
+		{" NBA ", SportNBA, false},
+		{"lcs", SportLCS, false},
+		{"mlb", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSport(tt.name)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidSport) {
+				t.Errorf("ParseSport(%q): expected ErrInvalidSport, got %v", tt.name, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseSport(%q) = %q, %v, expected %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestSportPositionsAndStats(t *testing.T) {
+	if !slices.Contains(SportNBA.Positions(), "PG") || slices.Contains(SportNBA.Positions(), "QB") {
+		t.Errorf("Unexpected NBA positions %v", SportNBA.Positions())
+	}
+	if !slices.Contains(SportNFL.Stats(), "pass_yd") || !slices.Contains(SportLCS.Stats(), "kills") {
+		t.Error("Expected sport specific stats")
+	}
+
+	// Callers get a copy
+	positions := SportNFL.Positions()
+	positions[0] = "changed"
+	if SportNFL.Positions()[0] == "changed" {
+		t.Error("Expected Positions to return a copy")
+	}
+
+	if Sport("mlb").Positions() != nil {
+		t.Error("Expected no positions for an invalid sport")
+	}
+}
+
+func TestInvalidSportSkipsRequest(t *testing.T) {
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL})
+
+	if _, err := client.GetSportState("mlb"); !errors.Is(err, ErrInvalidSport) {
+		t.Errorf("Expected ErrInvalidSport, got %v", err)
+	}
+	if _, err := client.GetAllPlayers("football"); !errors.Is(err, ErrInvalidSport) {
+		t.Errorf("Expected ErrInvalidSport, got %v", err)
+	}
+	if _, err := client.GetSchedule("", 2024, false); !errors.Is(err, ErrInvalidSport) {
+		t.Errorf("Expected ErrInvalidSport, got %v", err)
+	}
+
+	if n := hits.Load(); n != 0 {
+		t.Errorf("Expected no requests, got %d", n)
+	}
+}
+
+func TestGetProjectionsNBA(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projections/nba/2024/3" {
+			t.Errorf("Expected path /projections/nba/2024/3, got %s", r.URL.Path)
+		}
+		if positions := r.URL.Query()["position[]"]; !slices.Equal(positions, SportNBA.Positions()) {
+			t.Errorf("Expected NBA positions, got %v", positions)
+		}
+		w.Write([]byte(`[{"player_id":"1","sport":"nba","stats":{"pts":25.5,"reb":8,"pts_ppr":40}}]`))
+	}))
+	defer ts.Close()
+
+	client := NewClientWithOptions(ClientOptions{BaseURL: ts.URL})
+
+	projections, err := client.GetProjections(SportNBA, 2024, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(projections) != 1 || projections[0].Sport != SportNBA {
+		t.Fatalf("Expected 1 NBA projection, got %+v", projections)
+	}
+
+	stats := projections[0].StatValues()
+	if stats["pts"] != 25.5 || stats["reb"] != 8 || stats["pts_ppr"] != 40 {
+		t.Errorf("Unexpected stats %v", stats)
+	}
+	if projections[0].Stats.PtsPpr != 40 {
+		t.Errorf("Expected declared stat 40, got %v", projections[0].Stats.PtsPpr)
+	}
+}
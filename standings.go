@@ -0,0 +1,513 @@
+package sleeper
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+)
+
+// Tiebreaker decides the order of teams with the same record.
+type Tiebreaker int
+
+const (
+	TiebreakHeadToHead Tiebreaker = iota // Best record in games between the tied teams
+	TiebreakPointsFor                    // Most points scored
+	TiebreakCoinFlip                     // Random order, the same every time for a league
+)
+
+// DefaultTiebreakers are used when StandingsOptions.Tiebreakers is not set.
+var DefaultTiebreakers = []Tiebreaker{TiebreakHeadToHead, TiebreakPointsFor, TiebreakCoinFlip}
+
+// DefaultRegularSeasonWeeks is the last week counted in the standings when the league has no playoff week start.
+const DefaultRegularSeasonWeeks = 14
+
+// String returns the name of the tiebreaker.
+func (t Tiebreaker) String() string {
+	switch t {
+	case TiebreakHeadToHead:
+		return "head_to_head"
+	case TiebreakPointsFor:
+		return "points_for"
+	case TiebreakCoinFlip:
+		return "coin_flip"
+	default:
+		return fmt.Sprintf("tiebreaker(%d)", int(t))
+	}
+}
+
+// StandingsOptions control which weeks are counted and how ties are broken.
+type StandingsOptions struct {
+	Week        int          // Last week counted, defaults to the last completed regular season week
+	Tiebreakers []Tiebreaker // Applied in order to teams with the same record, defaults to DefaultTiebreakers
+	Seed        uint64       // Seed for TiebreakCoinFlip, defaults to one derived from the league ID
+}
+
+// TeamStanding is the record and rank of one team.
+type TeamStanding struct {
+	Rank          int    // Rank in the league starting at 1
+	Seed          int    // Playoff seed starting at 1, zero when the team misses the playoffs
+	Division      int    // Division from the roster settings, zero when the league has no divisions
+	DivisionRank  int    // Rank in the division starting at 1
+	RosterID      int    // Roster ID of the team
+	OwnerID       string // User ID of the roster owner
+	TeamName      string // Team name, or "Team " and the display name when it is not set
+	Wins          int
+	Losses        int
+	Ties          int
+	PointsFor     float32
+	PointsAgainst float32
+
+	// Record against the league median, zero unless League.Settings.LeagueAverageMatch is set
+	MedianWins   int
+	MedianLosses int
+	MedianTies   int
+}
+
+// Games returns the number of games the team played, including games against the league median.
+func (t TeamStanding) Games() int {
+	return t.Wins + t.Losses + t.Ties + t.MedianWins + t.MedianLosses + t.MedianTies
+}
+
+// WinPct returns the share of games won, including games against the league median
+// and counting ties as half a win.
+func (t TeamStanding) WinPct() float64 {
+	if t.Games() == 0 {
+		return 0
+	}
+	wins := float64(t.Wins+t.MedianWins) + float64(t.Ties+t.MedianTies)/2
+	return wins / float64(t.Games())
+}
+
+// Standings holds the teams in a league ordered by rank.
+type Standings struct {
+	LeagueID string
+	Week     int // Last week counted
+	Teams    []TeamStanding
+}
+
+// Team returns the standing for the roster.
+func (s Standings) Team(roster_id int) (TeamStanding, bool) {
+	for _, t := range s.Teams {
+		if t.RosterID == roster_id {
+			return t, true
+		}
+	}
+	return TeamStanding{}, false
+}
+
+// Playoffs returns the teams with a playoff seed ordered by seed.
+func (s Standings) Playoffs() []TeamStanding {
+	var teams []TeamStanding
+	for _, t := range s.Teams {
+		if t.Seed > 0 {
+			teams = append(teams, t)
+		}
+	}
+	slices.SortFunc(teams, func(a, b TeamStanding) int { return cmp.Compare(a.Seed, b.Seed) })
+	return teams
+}
+
+// Get the standings for the league computed from the matchups of each regular season week.
+// (GET `https://api.sleeper.app/v1/league/<league_id>/matchups/<week>` for each week)
+func (c *Client) GetStandings(league_id string, opts StandingsOptions) (Standings, error) {
+	return c.GetStandingsContext(context.Background(), league_id, opts)
+}
+
+// GetStandingsContext is like GetStandings but accepts a context.
+func (c *Client) GetStandingsContext(ctx context.Context, league_id string, opts StandingsOptions) (Standings, error) {
+	return FetchStandings(ctx, c, league_id, opts)
+}
+
+// FetchStandings gets the league, rosters, users, and regular season matchups from the api
+// and ranks the teams with BuildStandings. Without StandingsOptions.Week, the week in progress
+// is found from the sport state and left out so live scores are not counted as results.
+func FetchStandings(ctx context.Context, api LeagueAPI, league_id string, opts StandingsOptions) (Standings, error) {
+	league, err := api.GetLeagueContext(ctx, league_id)
+	if err != nil {
+		return Standings{}, err
+	}
+
+	completed := standingsWeek(league, opts)
+	if opts.Week <= 0 {
+		if completed, err = lastCompletedWeek(ctx, api, league); err != nil {
+			return Standings{}, err
+		}
+		opts.Week = completed
+	}
+
+	rosters, err := api.GetRostersContext(ctx, league_id)
+	if err != nil {
+		return Standings{}, err
+	}
+
+	users, err := api.GetLeagueUsersContext(ctx, league_id)
+	if err != nil {
+		return Standings{}, err
+	}
+
+	// Before the first week is completed every team is still without a game
+	weeks := map[int][]Matchup{}
+	if completed > 0 {
+		weeks, err = getSeasonMatchups(ctx, api, league_id, completed, DefaultBatchConcurrency)
+		if err != nil {
+			return Standings{}, err
+		}
+	}
+
+	standings := BuildStandings(league, rosters, users, weeks, opts)
+	standings.Week = completed
+
+	clientOf(api).debug(ctx, "sleeper: computed standings",
+		slog.String("league_id", league_id),
+		slog.Int("week", standings.Week),
+		slog.Int("teams", len(standings.Teams)),
+	)
+
+	return standings, nil
+}
+
+// BuildStandings computes the standings from the matchups for each week without sending
+// any requests. Teams are ranked by win percentage, counting ties as half a win, and teams
+// with the same record are ordered by the tiebreakers. Games where neither team scored are
+// treated as not played yet, and any other game is counted as final, so weeks still being
+// played should be left out with StandingsOptions.Week. In leagues with League.Settings.LeagueAverageMatch set, every
+// team also plays the median score of the week and the results count toward the record.
+//
+// The first League.Settings.PlayoffTeams teams get a playoff seed. When the league has
+// divisions the division winners get the top seeds.
+func BuildStandings(league League, rosters []Roster, users []LeagueUser, weeks map[int][]Matchup, opts StandingsOptions) Standings {
+	standings := Standings{
+		LeagueID: league.LeagueID,
+		Week:     standingsWeek(league, opts),
+	}
+
+	tiebreakers := opts.Tiebreakers
+	if len(tiebreakers) == 0 {
+		tiebreakers = DefaultTiebreakers
+	}
+
+	seed := opts.Seed
+	if seed == 0 {
+		h := fnv.New64a()
+		h.Write([]byte(league.LeagueID))
+		seed = h.Sum64()
+	}
+
+	table := newStandingsTable(rosters, users, league.Settings.Divisions > 1)
+	for week := 1; week <= standings.Week; week++ {
+		for _, game := range pairMatchups(weeks[week]) {
+			table.addGame(game[0], game[1])
+		}
+
+		if league.Settings.LeagueAverageMatch != 0 {
+			table.addMedian(weeks[week])
+		}
+	}
+
+	ranker := standingsRanker{
+		table:       table,
+		tiebreakers: tiebreakers,
+		flips:       make(map[int]float64),
+	}
+
+	// Flip for every team up front so the result does not depend on which teams are tied
+	flip := rand.New(rand.NewPCG(seed, seed))
+	for _, t := range table.teams {
+		ranker.flips[t.RosterID] = flip.Float64()
+	}
+
+	// Rank the league, then each division
+	ranked := ranker.rank(table.teams)
+	for i, t := range ranked {
+		t.Rank = i + 1
+	}
+
+	divisions := make(map[int][]*TeamStanding)
+	for _, t := range ranked {
+		divisions[t.Division] = append(divisions[t.Division], t)
+	}
+	for _, teams := range divisions {
+		for i, t := range ranker.rank(teams) {
+			t.DivisionRank = i + 1
+		}
+	}
+
+	// Division winners get the top seeds, then the rest by rank
+	order := ranked
+	if league.Settings.Divisions > 1 {
+		order = make([]*TeamStanding, 0, len(ranked))
+		for _, t := range ranked {
+			if t.DivisionRank == 1 {
+				order = append(order, t)
+			}
+		}
+		for _, t := range ranked {
+			if t.DivisionRank != 1 {
+				order = append(order, t)
+			}
+		}
+	}
+	for i, t := range order {
+		if i < league.Settings.PlayoffTeams {
+			t.Seed = i + 1
+		}
+	}
+
+	for _, t := range ranked {
+		standings.Teams = append(standings.Teams, *t)
+	}
+
+	return standings
+}
+
+// Get the last regular season week that has finished for the league, using the sport
+// state to leave out the week in progress. Past seasons count every regular season week.
+func lastCompletedWeek(ctx context.Context, api LeagueAPI, league League) (int, error) {
+	last := standingsWeek(league, StandingsOptions{})
+
+	state, err := api.GetSportStateContext(ctx, league.Sport)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case league.Season < state.Season:
+		return last, nil
+	case league.Season > state.Season || state.SeasonType == "pre":
+		return 0, nil
+	case state.SeasonType == "regular":
+		return max(min(state.Week-1, last), 0), nil
+	default:
+		return last, nil
+	}
+}
+
+// Get the last week counted in the standings.
+func standingsWeek(league League, opts StandingsOptions) int {
+	if opts.Week > 0 {
+		return opts.Week
+	}
+	if league.Settings.PlayoffWeekStart > 1 {
+		return league.Settings.PlayoffWeekStart - 1
+	}
+	return DefaultRegularSeasonWeeks
+}
+
+// Get the team name for a user, using "Team " and the display name when it is not set.
+func teamName(user LeagueUser) string {
+	if user.Metadata.TeamName != "" {
+		return user.Metadata.TeamName
+	}
+	return "Team " + user.DisplayName
+}
+
+// Get the points for a matchup, using the commissioner's override when there is one.
+func matchupPoints(m Matchup) float32 {
+	if m.CustomPoints != 0 {
+		return m.CustomPoints
+	}
+	return m.Points
+}
+
+// Pair the matchups for a week into games by matchup ID, ordered by matchup ID. Teams
+// without an opponent and games where neither team scored are skipped.
+func pairMatchups(matchups []Matchup) [][2]Matchup {
+	groups, _ := groupMatchups(matchups)
+
+	var games [][2]Matchup
+	for _, game := range groups {
+		if matchupPoints(game[0]) != 0 || matchupPoints(game[1]) != 0 {
+			games = append(games, game)
+		}
+	}
+
+	return games
+}
+
+// Records for every team and the results of the games between each pair of teams.
+type standingsTable struct {
+	teams  []*TeamStanding
+	byID   map[int]*TeamStanding
+	versus map[[2]int]float64 // Wins, counting ties as half, of the first roster against the second
+	played map[[2]int]int     // Games between the first and second roster
+}
+
+// Create a table with a team for each roster.
+func newStandingsTable(rosters []Roster, users []LeagueUser, divisions bool) *standingsTable {
+	table := &standingsTable{
+		byID:   make(map[int]*TeamStanding),
+		versus: make(map[[2]int]float64),
+		played: make(map[[2]int]int),
+	}
+
+	names := make(map[string]string)
+	for _, user := range users {
+		names[user.UserID] = teamName(user)
+	}
+
+	for _, roster := range rosters {
+		team := &TeamStanding{
+			RosterID: roster.RosterID,
+			OwnerID:  roster.OwnerID,
+			TeamName: names[roster.OwnerID],
+		}
+		if team.TeamName == "" {
+			team.TeamName = fmt.Sprintf("Team %d", roster.RosterID)
+		}
+		if divisions {
+			team.Division = roster.Settings.Division
+		}
+
+		table.teams = append(table.teams, team)
+		table.byID[roster.RosterID] = team
+	}
+
+	return table
+}
+
+// Add the result of a game to both teams.
+func (t *standingsTable) addGame(a, b Matchup) {
+	teamA, okA := t.byID[a.RosterID]
+	teamB, okB := t.byID[b.RosterID]
+	if !okA || !okB {
+		return
+	}
+
+	pointsA, pointsB := matchupPoints(a), matchupPoints(b)
+	teamA.PointsFor += pointsA
+	teamA.PointsAgainst += pointsB
+	teamB.PointsFor += pointsB
+	teamB.PointsAgainst += pointsA
+
+	ab, ba := [2]int{a.RosterID, b.RosterID}, [2]int{b.RosterID, a.RosterID}
+	t.played[ab]++
+	t.played[ba]++
+
+	switch matchResult(pointsA, pointsB) {
+	case ResultWin:
+		teamA.Wins++
+		teamB.Losses++
+		t.versus[ab]++
+	case ResultLoss:
+		teamB.Wins++
+		teamA.Losses++
+		t.versus[ba]++
+	default:
+		teamA.Ties++
+		teamB.Ties++
+		t.versus[ab] += 0.5
+		t.versus[ba] += 0.5
+	}
+}
+
+// Add the result against the league median for the week to each team that played.
+func (t *standingsTable) addMedian(matchups []Matchup) {
+	_, results, ok := medianResults(matchups)
+	if !ok {
+		return
+	}
+
+	for id, result := range results {
+		team, ok := t.byID[id]
+		if !ok {
+			continue
+		}
+
+		switch result {
+		case ResultWin:
+			team.MedianWins++
+		case ResultLoss:
+			team.MedianLosses++
+		default:
+			team.MedianTies++
+		}
+	}
+}
+
+// Orders teams by record and tiebreakers.
+type standingsRanker struct {
+	table       *standingsTable
+	tiebreakers []Tiebreaker
+	flips       map[int]float64 // Coin flip for each roster
+}
+
+// Order the teams by win percentage, breaking ties with the tiebreakers.
+func (r *standingsRanker) rank(teams []*TeamStanding) []*TeamStanding {
+	ranked := slices.Clone(teams)
+	slices.SortStableFunc(ranked, func(a, b *TeamStanding) int { return cmp.Compare(a.RosterID, b.RosterID) })
+
+	return r.order(ranked, func(t *TeamStanding, _ []*TeamStanding) float64 { return t.WinPct() }, r.tiebreakers)
+}
+
+// Sort the teams by key, highest first, and order teams with the same key with the next tiebreaker.
+func (r *standingsRanker) order(teams []*TeamStanding, key func(t *TeamStanding, group []*TeamStanding) float64, tiebreakers []Tiebreaker) []*TeamStanding {
+	if len(teams) <= 1 {
+		return teams
+	}
+
+	keys := make(map[*TeamStanding]float64, len(teams))
+	for _, t := range teams {
+		keys[t] = key(t, teams)
+	}
+	slices.SortStableFunc(teams, func(a, b *TeamStanding) int { return cmp.Compare(keys[b], keys[a]) })
+
+	if len(tiebreakers) == 0 {
+		return teams
+	}
+
+	next := r.tiebreakKey(tiebreakers[0])
+	for start := 0; start < len(teams); {
+		end := start + 1
+		for end < len(teams) && keys[teams[end]] == keys[teams[start]] {
+			end++
+		}
+		if end-start > 1 {
+			r.order(teams[start:end], next, tiebreakers[1:])
+		}
+		start = end
+	}
+
+	return teams
+}
+
+// Get the sort key for the tiebreaker.
+func (r *standingsRanker) tiebreakKey(tiebreaker Tiebreaker) func(t *TeamStanding, group []*TeamStanding) float64 {
+	switch tiebreaker {
+	case TiebreakHeadToHead:
+		return r.headToHead
+	case TiebreakPointsFor:
+		return func(t *TeamStanding, _ []*TeamStanding) float64 { return float64(t.PointsFor) }
+	case TiebreakCoinFlip:
+		return r.coinFlip
+	default:
+		return func(t *TeamStanding, _ []*TeamStanding) float64 { return 0 }
+	}
+}
+
+// Get the win percentage of the team in games against the rest of the group. Teams that
+// did not play the rest of the group are treated as .500.
+func (r *standingsRanker) headToHead(t *TeamStanding, group []*TeamStanding) float64 {
+	wins, games := 0.0, 0
+	for _, other := range group {
+		if other == t {
+			continue
+		}
+		pair := [2]int{t.RosterID, other.RosterID}
+		wins += r.table.versus[pair]
+		games += r.table.played[pair]
+	}
+
+	if games == 0 {
+		return 0.5
+	}
+	return wins / float64(games)
+}
+
+// Get the coin flip for the team.
+func (r *standingsRanker) coinFlip(t *TeamStanding, _ []*TeamStanding) float64 {
+	return r.flips[t.RosterID]
+}
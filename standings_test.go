@@ -0,0 +1,184 @@
+package sleeper
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+// League with four teams and three weeks of games, rosters 1 and 2 finish 2-1 and
+// roster 1 won the game between them.
+func newStandingsFake() *fakeLeagueAPI {
+	f := &fakeLeagueAPI{
+		league: League{LeagueID: "1"},
+		matchups: map[int][]Matchup{
+			1: {
+				{RosterID: 1, MatchupID: 1, Points: 100},
+				{RosterID: 2, MatchupID: 1, Points: 90},
+				{RosterID: 3, MatchupID: 2, Points: 80},
+				{RosterID: 4, MatchupID: 2, Points: 80},
+			},
+			2: {
+				{RosterID: 1, MatchupID: 1, Points: 70},
+				{RosterID: 3, MatchupID: 1, Points: 110},
+				{RosterID: 2, MatchupID: 2, Points: 120},
+				{RosterID: 4, MatchupID: 2, Points: 60},
+			},
+			3: {
+				{RosterID: 1, MatchupID: 1, Points: 95},
+				{RosterID: 4, MatchupID: 1, Points: 85},
+				{RosterID: 2, MatchupID: 2, Points: 100},
+				{RosterID: 3, MatchupID: 2, Points: 90},
+			},
+			4: {
+				{RosterID: 1, MatchupID: 1},
+				{RosterID: 2, MatchupID: 1},
+			},
+		},
+		rosters: []Roster{
+			{RosterID: 1, OwnerID: "a"},
+			{RosterID: 2, OwnerID: "b"},
+			{RosterID: 3, OwnerID: "c"},
+			{RosterID: 4, OwnerID: "d"},
+		},
+		users: []LeagueUser{
+			{UserID: "a", DisplayName: "alice"},
+			{UserID: "b", DisplayName: "bob"},
+			{UserID: "c", DisplayName: "carol"},
+			{UserID: "d", DisplayName: "dave"},
+		},
+	}
+	f.league.Settings.PlayoffTeams = 2
+	f.league.Settings.PlayoffWeekStart = 5
+	f.users[0].Metadata.TeamName = "Team A"
+	return f
+}
+
+// Get the roster IDs in rank order.
+func standingsOrder(s Standings) []int {
+	var ids []int
+	for _, t := range s.Teams {
+		ids = append(ids, t.RosterID)
+	}
+	return ids
+}
+
+func TestFetchStandings(t *testing.T) {
+	standings, err := FetchStandings(context.Background(), newStandingsFake(), "1", StandingsOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if standings.Week != 4 {
+		t.Errorf("Expected standings through week 4, got %d", standings.Week)
+	}
+
+	// Head-to-head puts roster 1 ahead of roster 2 even though roster 2 scored more
+	if order := standingsOrder(standings); !slices.Equal(order, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected order [1 2 3 4], got %v", order)
+	}
+
+	want := TeamStanding{Rank: 1, Seed: 1, DivisionRank: 1, RosterID: 1, OwnerID: "a", TeamName: "Team A", Wins: 2, Losses: 1, PointsFor: 265, PointsAgainst: 285}
+	if standings.Teams[0] != want {
+		t.Errorf("Expected %+v, got %+v", want, standings.Teams[0])
+	}
+
+	third, _ := standings.Team(3)
+	if third.Wins != 1 || third.Losses != 1 || third.Ties != 1 || third.TeamName != "Team carol" {
+		t.Errorf("Expected Team carol to be 1-1-1, got %+v", third)
+	}
+	if third.WinPct() != 0.5 {
+		t.Errorf("Expected win percentage 0.5, got %f", third.WinPct())
+	}
+
+	playoffs := standings.Playoffs()
+	if len(playoffs) != 2 || playoffs[0].RosterID != 1 || playoffs[1].RosterID != 2 {
+		t.Errorf("Expected rosters 1 and 2 in the playoffs, got %+v", playoffs)
+	}
+}
+
+func TestBuildStandingsTiebreakers(t *testing.T) {
+	f := newStandingsFake()
+
+	standings := BuildStandings(f.league, f.rosters, f.users, f.matchups, StandingsOptions{
+		Tiebreakers: []Tiebreaker{TiebreakPointsFor},
+	})
+	if order := standingsOrder(standings); !slices.Equal(order, []int{2, 1, 3, 4}) {
+		t.Errorf("Expected order [2 1 3 4], got %v", order)
+	}
+
+	// Coin flips are the same for the same seed
+	opts := StandingsOptions{Tiebreakers: []Tiebreaker{TiebreakCoinFlip}, Seed: 42}
+	first := standingsOrder(BuildStandings(f.league, f.rosters, f.users, f.matchups, opts))
+	for range 5 {
+		if order := standingsOrder(BuildStandings(f.league, f.rosters, f.users, f.matchups, opts)); !slices.Equal(order, first) {
+			t.Errorf("Expected coin flips to repeat %v, got %v", first, order)
+		}
+	}
+	if !slices.Equal(first[2:], []int{3, 4}) {
+		t.Errorf("Expected coin flip to only order tied teams, got %v", first)
+	}
+}
+
+func TestBuildStandingsDivisions(t *testing.T) {
+	f := newStandingsFake()
+	f.league.Settings.Divisions = 2
+	for i := range f.rosters {
+		f.rosters[i].Settings.Division = 1 + i/2
+	}
+
+	standings := BuildStandings(f.league, f.rosters, f.users, f.matchups, StandingsOptions{})
+
+	seeds := make(map[int]int)
+	for _, team := range standings.Teams {
+		seeds[team.RosterID] = team.Seed
+	}
+
+	// Roster 3 wins division 2 and takes the second seed from roster 2
+	if seeds[1] != 1 || seeds[3] != 2 || seeds[2] != 0 {
+		t.Errorf("Expected division winners 1 and 3 to be seeded, got %v", seeds)
+	}
+
+	second, _ := standings.Team(2)
+	if second.Division != 1 || second.DivisionRank != 2 || second.Rank != 2 {
+		t.Errorf("Expected roster 2 to be second in division 1 and the league, got %+v", second)
+	}
+}
+
+func TestFetchStandingsSkipsWeekInProgress(t *testing.T) {
+	tests := []struct {
+		season     string
+		seasonType string
+		week       int
+		want       int
+	}{
+		{"2024", "regular", 3, 2},  // Week 3 is being played
+		{"2024", "regular", 10, 4}, // Past the regular season
+		{"2024", "pre", 0, 0},
+		{"2024", "post", 16, 4},
+		{"2025", "regular", 3, 4}, // The league is from an earlier season
+	}
+
+	for _, tt := range tests {
+		f := newStandingsFake()
+		f.league.Season = "2024"
+		f.state = SportState{Season: tt.season, SeasonType: tt.seasonType, Week: tt.week}
+
+		standings, err := FetchStandings(context.Background(), f, "1", StandingsOptions{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if standings.Week != tt.want {
+			t.Errorf("Expected standings through week %d for %s %s week %d, got %d", tt.want, tt.season, tt.seasonType, tt.week, standings.Week)
+		}
+
+		games := 0
+		for _, team := range standings.Teams {
+			games += team.Games()
+		}
+		// Week 4 has not been scored in the fake, so at most three weeks of games count
+		if want := min(tt.want, 3) * 4; games != want {
+			t.Errorf("Expected %d team games through week %d, got %d", want, tt.want, games)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package sleeper
+
+import "net/http"
+
+// RoundTripFunc sends an HTTP request and returns its response.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the function that sends each request to the Sleeper API. It can
+// inspect or modify the request before calling next and the response after it returns.
+//
+// Middleware runs once for every attempt, so retried requests are seen multiple times.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// SetHeader returns a Middleware that sets the header on every request.
+func SetHeader(key string, value string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set(key, value)
+			return next(req)
+		}
+	}
+}
+
+// Send the request through the middleware chain. The first middleware is the outermost.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	next := RoundTripFunc(c.httpClient.Do)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		next = c.middleware[i](next)
+	}
+	return next(req)
+}
@@ -0,0 +1,141 @@
+package sleeper
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type staticTransport struct {
+	body     string
+	requests []*http.Request
+}
+
+func (s *staticTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.requests = append(s.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(s.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestClientOptionsHTTPClient(t *testing.T) {
+	transport := &staticTransport{body: `{"league_id": "123"}`}
+	httpClient := &http.Client{Transport: transport}
+
+	client := NewClientWithOptions(ClientOptions{
+		HTTPClient: httpClient,
+		Timeout:    5 * time.Second,
+	})
+
+	league, err := client.GetLeague("123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if league.LeagueID != "123" {
+		t.Errorf("Expected league ID 123, got %s", league.LeagueID)
+	}
+	if len(transport.requests) != 1 || transport.requests[0].URL.String() != sleeperBaseURL+"/v1/league/123" {
+		t.Errorf("Expected one request to the league endpoint, got %v", transport.requests)
+	}
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Errorf("Expected timeout %v, got %v", 5*time.Second, client.httpClient.Timeout)
+	}
+	if httpClient.Timeout != 0 {
+		t.Errorf("Expected provided HTTP client to be unchanged, got timeout %v", httpClient.Timeout)
+	}
+}
+
+func TestClientOptionsMiddleware(t *testing.T) {
+	var gotAgent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAgent = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+
+	var order []string
+	record := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name+" "+req.Method+" "+req.URL.Path+" "+req.Header.Get("User-Agent"))
+				resp, err := next(req)
+				order = append(order, name+" done")
+				return resp, err
+			}
+		}
+	}
+
+	client := NewClientWithOptions(ClientOptions{
+		BaseURL: ts.URL,
+		Middleware: []Middleware{
+			record("first"),
+			SetHeader("User-Agent", "my-bot/1.0"),
+			record("second"),
+		},
+	})
+
+	if _, err := client.GetRosters("123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{
+		"first GET /v1/league/123/rosters ",
+		"second GET /v1/league/123/rosters my-bot/1.0",
+		"second done",
+		"first done",
+	}
+	if strings.Join(order, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected middleware order %q, got %q", expected, order)
+	}
+	if gotAgent != "my-bot/1.0" {
+		t.Errorf("Expected User-Agent my-bot/1.0, got %s", gotAgent)
+	}
+}
+
+func TestMiddlewareFaultInjectionIsRetried(t *testing.T) {
+	transport := &staticTransport{body: `{}`}
+	faults := 2
+
+	client := NewClientWithOptions(ClientOptions{
+		HTTPClient: &http.Client{Transport: transport},
+		Retry: RetryPolicy{
+			MaxAttempts: 3,
+			BaseBackoff: time.Millisecond,
+		},
+		Middleware: []Middleware{
+			func(next RoundTripFunc) RoundTripFunc {
+				return func(req *http.Request) (*http.Response, error) {
+					if faults > 0 {
+						faults--
+						return &http.Response{
+							StatusCode: http.StatusServiceUnavailable,
+							Header:     http.Header{},
+							Body:       io.NopCloser(strings.NewReader("")),
+						}, nil
+					}
+					return next(req)
+				}
+			},
+		},
+	})
+
+	if _, err := client.GetSportState("nfl"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(transport.requests) != 1 {
+		t.Errorf("Expected 1 request to reach the transport, got %d", len(transport.requests))
+	}
+
+	faults = 5
+	if _, err := client.GetSportState("nfl"); !errors.Is(err, ErrServerError) {
+		t.Errorf("Expected ErrServerError, got %v", err)
+	}
+}
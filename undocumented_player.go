@@ -1,15 +1,20 @@
 package sleeper
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 )
 
 type PlayerResearch struct {
 	Owned   float64 `json:"owned"`
 	Started float64 `json:"started"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 type PlayerStats struct {
@@ -138,7 +143,7 @@ type PlayerStats struct {
 	Week         any    `json:"week"`
 	Season       string `json:"season"`
 	SeasonType   string `json:"season_type"`
-	Sport        string `json:"sport"`
+	Sport        Sport  `json:"sport"`
 	PlayerID     string `json:"player_id"`
 	GameID       string `json:"game_id"`
 	UpdatedAt    any    `json:"updated_at"`
@@ -146,68 +151,139 @@ type PlayerStats struct {
 	Company      string `json:"company"`
 	Opponent     any    `json:"opponent"`
 	Player       Player `json:"player"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
-// Get specific NFL player details.
-// (GET `https://api.sleeper.app/players/nfl/<player id>)
-func (c *Client) GetNflPlayer(playerID int) (Player, error) {
+// StatValues returns every stat by key, including stats for sports other than the NFL.
+func (s PlayerStats) StatValues() map[string]float64 {
+	return rawStats(s.Raw)
+}
+
+// Get specific player details for a sport.
+// (GET `https://api.sleeper.app/player/<sport>/<player id>`)
+func (c *Client) GetPlayer(sport Sport, playerID int) (Player, error) {
+	return c.GetPlayerContext(context.Background(), sport, playerID)
+}
+
+// GetPlayerContext is like GetPlayer but accepts a context.
+func (c *Client) GetPlayerContext(ctx context.Context, sport Sport, playerID int) (Player, error) {
 	player := Player{}
 
-	url := fmt.Sprintf("%s/player/nfl/%d", c.sleeperURL, playerID)
+	if err := sport.Validate(); err != nil {
+		return player, err
+	}
+
+	url := fmt.Sprintf("%s/player/%s/%d", c.sleeperURL, sport, playerID)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return player, err
 	}
 
-	err = json.Unmarshal(data, &player)
+	if err := c.decodeJSON(ctx, url, data, &player); err != nil {
+		return player, err
+	}
+
+	keepRaw(data, reflect.TypeFor[Player](), &player.Raw, &player.Unknown)
+	return player, nil
+}
 
-	return player, err
+// Get specific NFL player details.
+// (GET `https://api.sleeper.app/players/nfl/<player id>)
+func (c *Client) GetNflPlayer(playerID int) (Player, error) {
+	return c.GetNflPlayerContext(context.Background(), playerID)
 }
 
-// Get NFL players research
-// `GET https://api.sleeper.app/players/nfl/research/<regular or post>/<year>/<week>`
-func (c *Client) GetNflPlayerResearch(year int, week int, postseason bool) (map[string]PlayerResearch, error) {
+// GetNflPlayerContext is like GetNflPlayer but accepts a context.
+func (c *Client) GetNflPlayerContext(ctx context.Context, playerID int) (Player, error) {
+	return c.GetPlayerContext(ctx, SportNFL, playerID)
+}
+
+// Get players research for a sport
+// `GET https://api.sleeper.app/players/<sport>/research/<regular or post>/<year>/<week>`
+func (c *Client) GetPlayerResearch(sport Sport, year int, week int, postseason bool) (map[string]PlayerResearch, error) {
+	return c.GetPlayerResearchContext(context.Background(), sport, year, week, postseason)
+}
+
+// GetPlayerResearchContext is like GetPlayerResearch but accepts a context.
+func (c *Client) GetPlayerResearchContext(ctx context.Context, sport Sport, year int, week int, postseason bool) (map[string]PlayerResearch, error) {
 	var results map[string]PlayerResearch
 	reg := "regular"
 	if postseason {
 		reg = "post"
 	}
 
+	if err := sport.Validate(); err != nil {
+		return results, err
+	}
+
 	// Sleeper only has data from 2009 to present
 	if year < 2009 || year > time.Now().Year() {
 		return results, errors.New("invalid year - must be between 2008 and current")
 	}
 
-	url := fmt.Sprintf("%s/players/nfl/research/%s/%d/%d", c.sleeperURL, reg, year, week)
+	url := fmt.Sprintf("%s/players/%s/research/%s/%d/%d", c.sleeperURL, sport, reg, year, week)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return results, err
 	}
 
-	err = json.Unmarshal(data, &results)
+	err = c.decodeJSON(ctx, url, data, &results)
 
 	return results, err
 }
 
-// Get NFL player season stats
-// `GET https://api.sleeper.app/stats/nfl/player/<player id>?season_type=<regular or post>&season=<season>`
-func (c *Client) GetNflPlayerSeasonStats(playerID int, year int, postseason bool) (PlayerStats, error) {
+// Get NFL players research
+// `GET https://api.sleeper.app/players/nfl/research/<regular or post>/<year>/<week>`
+func (c *Client) GetNflPlayerResearch(year int, week int, postseason bool) (map[string]PlayerResearch, error) {
+	return c.GetNflPlayerResearchContext(context.Background(), year, week, postseason)
+}
+
+// GetNflPlayerResearchContext is like GetNflPlayerResearch but accepts a context.
+func (c *Client) GetNflPlayerResearchContext(ctx context.Context, year int, week int, postseason bool) (map[string]PlayerResearch, error) {
+	return c.GetPlayerResearchContext(ctx, SportNFL, year, week, postseason)
+}
+
+// Get player season stats for a sport
+// `GET https://api.sleeper.app/stats/<sport>/player/<player id>?season_type=<regular or post>&season=<season>`
+func (c *Client) GetPlayerSeasonStats(sport Sport, playerID int, year int, postseason bool) (PlayerStats, error) {
+	return c.GetPlayerSeasonStatsContext(context.Background(), sport, playerID, year, postseason)
+}
+
+// GetPlayerSeasonStatsContext is like GetPlayerSeasonStats but accepts a context.
+func (c *Client) GetPlayerSeasonStatsContext(ctx context.Context, sport Sport, playerID int, year int, postseason bool) (PlayerStats, error) {
 	stats := PlayerStats{}
 	reg := "regular"
 	if postseason {
 		reg = "post"
 	}
 
-	url := fmt.Sprintf("%s/stats/nfl/player/%d?season_type=%s&season=%d", c.sleeperURL, playerID, reg, year)
+	if err := sport.Validate(); err != nil {
+		return stats, err
+	}
+
+	url := fmt.Sprintf("%s/stats/%s/player/%d?season_type=%s&season=%d", c.sleeperURL, sport, playerID, reg, year)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return stats, err
 	}
 
-	err = json.Unmarshal(data, &stats)
+	err = c.decodeJSON(ctx, url, data, &stats)
 
 	return stats, err
 }
+
+// Get NFL player season stats
+// `GET https://api.sleeper.app/stats/nfl/player/<player id>?season_type=<regular or post>&season=<season>`
+func (c *Client) GetNflPlayerSeasonStats(playerID int, year int, postseason bool) (PlayerStats, error) {
+	return c.GetNflPlayerSeasonStatsContext(context.Background(), playerID, year, postseason)
+}
+
+// GetNflPlayerSeasonStatsContext is like GetNflPlayerSeasonStats but accepts a context.
+func (c *Client) GetNflPlayerSeasonStatsContext(ctx context.Context, playerID int, year int, postseason bool) (PlayerStats, error) {
+	return c.GetPlayerSeasonStatsContext(ctx, SportNFL, playerID, year, postseason)
+}
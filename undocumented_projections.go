@@ -1,13 +1,16 @@
 package sleeper
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 )
 
-type Projections []struct {
+type Projections []Projection
+
+type Projection struct {
 	Date  string `json:"date"`
 	Stats struct {
 		AdpDdPpr       float64 `json:"adp_dd_ppr"`
@@ -69,33 +72,74 @@ type Projections []struct {
 	Week       int    `json:"week"`
 	Season     string `json:"season"`
 	SeasonType string `json:"season_type"`
-	Sport      string `json:"sport"`
+	Sport      Sport  `json:"sport"`
 	PlayerID   string `json:"player_id"`
 	GameID     string `json:"game_id"`
 	Team       string `json:"team"`
 	Company    string `json:"company"`
 	Opponent   string `json:"opponent"`
 	Player     Player `json:"player"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
-// Get NFL player score projections for a specific season and week.
-// (GET `https://api.sleeper.app/projections/nfl/<season>/<week>?season_type=regular&position[]=FLEX&position[]=K&position[]=QB&position[]=RB&position[]=TE&position[]=WR&position[]=DEF`)
-func (c *Client) GetNflProjections(season int, week int) (Projections, error) {
+// StatValues returns every projected stat by key, including stats for sports other than the NFL.
+func (p Projection) StatValues() map[string]float64 {
+	return rawStats(p.Raw)
+}
+
+// Get player score projections for a sport, season, and week.
+// (GET `https://api.sleeper.app/projections/<sport>/<season>/<week>?season_type=regular&position[]=<position>`)
+func (c *Client) GetProjections(sport Sport, season int, week int) (Projections, error) {
+	return c.GetProjectionsContext(context.Background(), sport, season, week)
+}
+
+// GetProjectionsContext is like GetProjections but accepts a context.
+func (c *Client) GetProjectionsContext(ctx context.Context, sport Sport, season int, week int) (Projections, error) {
 	projections := Projections{}
 
+	if err := sport.Validate(); err != nil {
+		return projections, err
+	}
+
 	// Sleeper only has data from 2009 to present
 	if season < 2009 || season > time.Now().Year() {
 		return projections, errors.New("invalid year - must be between 2008 and current")
 	}
 
-	url := fmt.Sprintf("%s/projections/nfl/%d/%d?eason_type=regular&position[]=FLEX&position[]=K&position[]=QB&position[]=RB&position[]=TE&position[]=WR&position[]=DEF", c.sleeperURL, season, week)
+	query := "season_type=regular"
+	for _, position := range projectionPositions(sport) {
+		query += "&position[]=" + position
+	}
+
+	url := fmt.Sprintf("%s/projections/%s/%d/%d?%s", c.sleeperURL, sport, season, week, query)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return projections, err
 	}
 
-	err = json.Unmarshal(data, &projections)
+	err = c.decodeJSON(ctx, url, data, &projections)
 
 	return projections, err
 }
+
+// Get the positions to request projections for. NFL projections include FLEX.
+func projectionPositions(sport Sport) []string {
+	if sport == SportNFL {
+		return []string{"FLEX", "K", "QB", "RB", "TE", "WR", "DEF"}
+	}
+	return sport.Positions()
+}
+
+// Get NFL player score projections for a specific season and week.
+// (GET `https://api.sleeper.app/projections/nfl/<season>/<week>?season_type=regular&position[]=FLEX&position[]=K&position[]=QB&position[]=RB&position[]=TE&position[]=WR&position[]=DEF`)
+func (c *Client) GetNflProjections(season int, week int) (Projections, error) {
+	return c.GetNflProjectionsContext(context.Background(), season, week)
+}
+
+// GetNflProjectionsContext is like GetNflProjections but accepts a context.
+func (c *Client) GetNflProjectionsContext(ctx context.Context, season int, week int) (Projections, error) {
+	return c.GetProjectionsContext(ctx, SportNFL, season, week)
+}
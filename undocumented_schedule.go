@@ -1,43 +1,72 @@
 package sleeper
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 )
 
-type NflSchedule []struct {
+type ScheduleGame struct {
 	Status string `json:"status"`
 	Date   string `json:"date"`
 	Home   string `json:"home"`
 	Week   int    `json:"week"`
 	GameID string `json:"game_id"`
 	Away   string `json:"away"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
-// Get NFL schedule.
-// `GET https://api.sleeper.app/schedule/nfl/<regular or post>/<year>`
-func (c *Client) GetNflSchedule(year int, postseason bool) (NflSchedule, error) {
-	schedule := NflSchedule{}
+type Schedule []ScheduleGame
+
+// NflSchedule is the schedule returned by GetNflSchedule.
+type NflSchedule = Schedule
+
+// Get the schedule for a sport.
+// `GET https://api.sleeper.app/schedule/<sport>/<regular or post>/<year>`
+func (c *Client) GetSchedule(sport Sport, year int, postseason bool) (Schedule, error) {
+	return c.GetScheduleContext(context.Background(), sport, year, postseason)
+}
+
+// GetScheduleContext is like GetSchedule but accepts a context.
+func (c *Client) GetScheduleContext(ctx context.Context, sport Sport, year int, postseason bool) (Schedule, error) {
+	schedule := Schedule{}
 	reg := "regular"
 	if postseason {
 		reg = "post"
 	}
 
+	if err := sport.Validate(); err != nil {
+		return schedule, err
+	}
+
 	// Sleeper only has data from 2009 to present
 	if year < 2009 || year > time.Now().Year() {
 		return schedule, errors.New("invalid year - must be between 2008 and current")
 	}
 
-	url := fmt.Sprintf("%s/schedule/nfl/%s/%d", c.sleeperURL, reg, year)
+	url := fmt.Sprintf("%s/schedule/%s/%s/%d", c.sleeperURL, sport, reg, year)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return schedule, err
 	}
 
-	err = json.Unmarshal(data, &schedule)
+	err = c.decodeJSON(ctx, url, data, &schedule)
 
 	return schedule, err
 }
+
+// Get NFL schedule.
+// `GET https://api.sleeper.app/schedule/nfl/<regular or post>/<year>`
+func (c *Client) GetNflSchedule(year int, postseason bool) (NflSchedule, error) {
+	return c.GetNflScheduleContext(context.Background(), year, postseason)
+}
+
+// GetNflScheduleContext is like GetNflSchedule but accepts a context.
+func (c *Client) GetNflScheduleContext(ctx context.Context, year int, postseason bool) (NflSchedule, error) {
+	return c.GetScheduleContext(ctx, SportNFL, year, postseason)
+}
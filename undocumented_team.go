@@ -1,6 +1,7 @@
 package sleeper
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -31,21 +32,29 @@ type TeamDepthChart struct {
 	Wr1  []string `json:"WR1"`
 	Wr2  []string `json:"WR2"`
 	Wr3  []string `json:"WR3"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 // Get NFL team depth chart.
 // `GET https://api.sleeper.app/players/nfl/<team>/depth_chart`
 func (c *Client) GetNflTeamDepthChart(team string) (TeamDepthChart, error) {
+	return c.GetNflTeamDepthChartContext(context.Background(), team)
+}
+
+// GetNflTeamDepthChartContext is like GetNflTeamDepthChart but accepts a context.
+func (c *Client) GetNflTeamDepthChartContext(ctx context.Context, team string) (TeamDepthChart, error) {
 	tdc := TeamDepthChart{}
 
 	url := fmt.Sprintf("%s/players/nfl/%s/depth_chart", c.sleeperURL, team)
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return tdc, err
 	}
 
-	err = json.Unmarshal(data, &tdc)
+	err = c.decodeJSON(ctx, url, data, &tdc)
 
 	return tdc, err
 }
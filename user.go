@@ -1,6 +1,7 @@
 package sleeper
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -27,31 +28,44 @@ type User struct {
 	UserID         string      `json:"user_id"`
 	Username       string      `json:"username"`
 	Verification   interface{} `json:"verification"`
+
+	Raw     json.RawMessage            `json:"-"` // JSON the model was decoded from
+	Unknown map[string]json.RawMessage `json:"-"` // Fields not declared on the model, keyed by dotted path
 }
 
 // Get the user's information by their username.
 // (GET `https://api.sleeper.app/v1/user/<username>`)
 func (c *Client) GetUserByUsername(username string) (User, error) {
+	return c.GetUserByUsernameContext(context.Background(), username)
+}
+
+// GetUserByUsernameContext is like GetUserByUsername but accepts a context.
+func (c *Client) GetUserByUsernameContext(ctx context.Context, username string) (User, error) {
 	url := fmt.Sprintf("%s/v1/user/%s", c.sleeperURL, username)
-	return c.getUser(url)
+	return c.getUser(ctx, url)
 }
 
 // Get the user's information by their user id.
 // (GET `https://api.sleeper.app/v1/user/<user_id>`)
 func (c *Client) GetUserByID(id string) (User, error) {
+	return c.GetUserByIDContext(context.Background(), id)
+}
+
+// GetUserByIDContext is like GetUserByID but accepts a context.
+func (c *Client) GetUserByIDContext(ctx context.Context, id string) (User, error) {
 	url := fmt.Sprintf("%s/v1/user/%s", c.sleeperURL, id)
-	return c.getUser(url)
+	return c.getUser(ctx, url)
 }
 
-func (c *Client) getUser(url string) (User, error) {
+func (c *Client) getUser(ctx context.Context, url string) (User, error) {
 	user := User{}
 
-	data, err := c.getRequest(url)
+	data, err := c.getRequestContext(ctx, url)
 	if err != nil {
 		return user, err
 	}
 
-	err = json.Unmarshal(data, &user)
+	err = c.decodeJSON(ctx, url, data, &user)
 
 	return user, err
 }